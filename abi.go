@@ -278,13 +278,16 @@ type Event struct {
 	Inputs    *Type
 }
 
-// NewEvent creates a new solidity event object using the signature
+// NewEvent creates a new solidity event object using the signature, which ends with 'anonymous' for anonymous events
 func NewEvent(name string) (*Event, error) {
-	name, typ, err := parseEventOrErrorSignature("event ", name)
+	anonymous := strings.HasSuffix(name, " anonymous")
+	name, typ, err := parseEventOrErrorSignature("event ", strings.TrimSpace(strings.TrimSuffix(name, " anonymous")))
 	if err != nil {
 		return nil, err
 	}
-	return NewEventFromType(name, typ), nil
+	event := NewEventFromType(name, typ)
+	event.Anonymous = anonymous
+	return event, nil
 }
 
 // NewEventFromType creates a new solidity event object using the name and type
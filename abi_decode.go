@@ -10,8 +10,6 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
-
-	"github.com/mitchellh/mapstructure"
 )
 
 // Decode decodes the input with a given type
@@ -29,20 +27,7 @@ func DecodeStruct(t *Type, input []byte, out interface{}) error {
 	if err != nil {
 		return err
 	}
-
-	dc := &mapstructure.DecoderConfig{
-		Result:           out,
-		WeaklyTypedInput: true,
-		TagName:          "abi",
-	}
-	ms, err := mapstructure.NewDecoder(dc)
-	if err != nil {
-		return err
-	}
-	if err = ms.Decode(val); err != nil {
-		return err
-	}
-	return nil
+	return decodeMapToStruct(val, out)
 }
 
 func decode(t *Type, input []byte) (interface{}, []byte, error) {
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package hiero
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// DecodedLog is a contract log decoded with the event of an ABI which emitted it
+type DecodedLog struct {
+	Event  *Event
+	Values map[string]interface{}
+	Log    ContractLogInfo
+}
+
+// DecodeStruct decodes the values of the log to a struct
+func (d *DecodedLog) DecodeStruct(out interface{}) error {
+	return decodeMapToStruct(d.Values, out)
+}
+
+// Sig returns the signature of the event
+func (e *Event) Sig() string {
+	return buildSignature(e.Name, e.Inputs)
+}
+
+// ID returns the id of the event, which is the first topic of the logs of non anonymous events
+func (e *Event) ID() (res Hash) {
+	k := acquireKeccak()
+	k.Write([]byte(e.Sig()))
+	dst := k.Sum(nil)
+	releaseKeccak(k)
+	copy(res[:], dst)
+	return
+}
+
+// Match returns true if the log could have been emitted by this event
+func (e *Event) Match(log ContractLogInfo) bool {
+	topics := e.indexedCount()
+	if !e.Anonymous {
+		if len(log.Topics) == 0 || !bytes.Equal(log.Topics[0], e.ID().Bytes()) {
+			return false
+		}
+		topics++
+	}
+	return len(log.Topics) == topics
+}
+
+// ParseLog decodes the indexed topics and the data of a log of this event. Indexed values of dynamic types, arrays
+// and tuples are only stored as the Keccak-256 hash of their encoding, so they are decoded as a Hash. Unnamed
+// inputs are keyed by their position.
+func (e *Event) ParseLog(log ContractLogInfo) (map[string]interface{}, error) {
+	if !e.Match(log) {
+		return nil, fmt.Errorf("log does not match event '%s'", e.Name)
+	}
+
+	topics := log.Topics
+	if !e.Anonymous {
+		topics = topics[1:]
+	}
+
+	res := map[string]interface{}{}
+	nonIndexed := []*TupleElem{}
+	for indx, arg := range e.Inputs.tuple {
+		name := arg.Name
+		if name == "" {
+			name = strconv.Itoa(indx)
+		}
+
+		if !arg.Indexed {
+			nonIndexed = append(nonIndexed, &TupleElem{Name: name, Elem: arg.Elem})
+			continue
+		}
+
+		val, err := parseTopic(arg.Elem, topics[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode topic '%s': %v", name, err)
+		}
+		topics = topics[1:]
+
+		if _, ok := res[name]; ok {
+			return nil, fmt.Errorf("tuple with repeated values")
+		}
+		res[name] = val
+	}
+
+	if len(nonIndexed) == 0 {
+		return res, nil
+	}
+
+	data, err := Decode(NewTupleType(nonIndexed), log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode data: %v", err)
+	}
+	for name, val := range data.(map[string]interface{}) {
+		if _, ok := res[name]; ok {
+			return nil, fmt.Errorf("tuple with repeated values")
+		}
+		res[name] = val
+	}
+	return res, nil
+}
+
+// ParseLogStruct decodes a log of this event to a struct
+func (e *Event) ParseLogStruct(log ContractLogInfo, out interface{}) error {
+	val, err := e.ParseLog(log)
+	if err != nil {
+		return err
+	}
+	return decodeMapToStruct(val, out)
+}
+
+func (e *Event) indexedCount() int {
+	count := 0
+	for _, arg := range e.Inputs.tuple {
+		if arg.Indexed {
+			count++
+		}
+	}
+	return count
+}
+
+// DecodeLogs decodes the logs emitted by the events of the ABI, such as the LogInfo of a ContractFunctionResult.
+// A log is matched to a non anonymous event by its first topic and its number of topics, and otherwise to the first
+// anonymous event, by name, which decodes it. Logs of events not in the ABI, like the ones of other contracts, are
+// skipped, and so are logs with the ID of an event but other indexed inputs, like an ERC-721 Transfer for an ERC-20
+// ABI. An error is only returned when the data of a matching log can't be decoded.
+func (a *ABI) DecodeLogs(logs []ContractLogInfo) ([]*DecodedLog, error) {
+	byID := map[Hash]*Event{}
+	anonymous := []*Event{}
+	for _, name := range a.sortedEventNames() {
+		event := a.Events[name]
+		if event.Anonymous {
+			anonymous = append(anonymous, event)
+		} else {
+			byID[event.ID()] = event
+		}
+	}
+
+	res := []*DecodedLog{}
+	for indx, log := range logs {
+		if len(log.Topics) > 0 && len(log.Topics[0]) == 32 {
+			var id Hash
+			copy(id[:], log.Topics[0])
+			if event, ok := byID[id]; ok && event.Match(log) {
+				val, err := event.ParseLog(log)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode log %d: %v", indx, err)
+				}
+				res = append(res, &DecodedLog{Event: event, Values: val, Log: log})
+				continue
+			}
+		}
+
+		for _, event := range anonymous {
+			val, err := event.ParseLog(log)
+			if err == nil {
+				res = append(res, &DecodedLog{Event: event, Values: val, Log: log})
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (a *ABI) sortedEventNames() []string {
+	names := make([]string, 0, len(a.Events))
+	for name := range a.Events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func parseTopic(t *Type, topic []byte) (interface{}, error) {
+	if len(topic) != 32 {
+		return nil, fmt.Errorf("topic should be 32 bytes but found %d", len(topic))
+	}
+
+	switch t.kind {
+	case KindString, KindBytes, KindSlice, KindArray, KindTuple:
+		var res Hash
+		copy(res[:], topic)
+		return res, nil
+	}
+
+	val, _, err := decode(t, topic)
+	return val, err
+}
+
+func decodeMapToStruct(val interface{}, out interface{}) error {
+	dc := &mapstructure.DecoderConfig{
+		Result:           out,
+		WeaklyTypedInput: true,
+		TagName:          "abi",
+	}
+	ms, err := mapstructure.NewDecoder(dc)
+	if err != nil {
+		return err
+	}
+	return ms.Decode(val)
+}
@@ -0,0 +1,177 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbiEventParseLog(t *testing.T) {
+	t.Parallel()
+
+	event, err := NewEvent("event Transfer(address indexed from, address indexed to, uint256 value)")
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer(address,address,uint256)", event.Sig())
+	assert.Equal(t, "ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", hex.EncodeToString(event.ID().Bytes()))
+
+	from := BytesToAddress([]byte{0x01, 0x02})
+	to := BytesToAddress([]byte{0x03})
+	data, err := Encode(map[string]interface{}{"value": big.NewInt(1000)}, mustNewType(t, "tuple(uint256 value)"))
+	require.NoError(t, err)
+
+	log := ContractLogInfo{
+		Topics: [][]byte{event.ID().Bytes(), leftPad(from.Bytes(), 32), leftPad(to.Bytes(), 32)},
+		Data:   data,
+	}
+	values, err := event.ParseLog(log)
+	require.NoError(t, err)
+	assert.Equal(t, from, values["from"])
+	assert.Equal(t, to, values["to"])
+	assert.Equal(t, big.NewInt(1000), values["value"])
+
+	var transfer struct {
+		From  Address
+		To    Address
+		Value *big.Int
+	}
+	require.NoError(t, event.ParseLogStruct(log, &transfer))
+	assert.Equal(t, from, transfer.From)
+	assert.Equal(t, big.NewInt(1000), transfer.Value)
+
+	// A log of another event, and one with a missing topic
+	_, err = event.ParseLog(ContractLogInfo{Topics: [][]byte{make([]byte, 32), log.Topics[1], log.Topics[2]}, Data: data})
+	require.ErrorContains(t, err, "does not match event")
+	_, err = event.ParseLog(ContractLogInfo{Topics: log.Topics[:2], Data: data})
+	require.Error(t, err)
+	_, err = event.ParseLog(ContractLogInfo{Topics: log.Topics, Data: data[:16]})
+	require.ErrorContains(t, err, "failed to decode data")
+}
+
+func TestAbiEventParseLogHashedTopics(t *testing.T) {
+	t.Parallel()
+
+	event, err := NewEvent("event Named(string indexed name, uint256[] indexed ids, tuple(uint8 a) indexed pair, int8 indexed delta, string note, bytes)")
+	require.NoError(t, err)
+	assert.Equal(t, "Named(string,uint256[],(uint8),int8,string,bytes)", event.Sig())
+
+	data, err := Encode([]interface{}{"hello", []byte{0xca, 0xfe}}, mustNewType(t, "tuple(string, bytes)"))
+	require.NoError(t, err)
+
+	nameHash := Keccak256Hash([]byte("alice"))
+	delta := make([]byte, 32)
+	for i := range delta {
+		delta[i] = 0xff
+	}
+
+	values, err := event.ParseLog(ContractLogInfo{
+		Topics: [][]byte{event.ID().Bytes(), nameHash.Bytes(), make([]byte, 32), make([]byte, 32), delta},
+		Data:   data,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, nameHash, values["name"])
+	assert.Equal(t, Hash{}, values["ids"])
+	assert.Equal(t, Hash{}, values["pair"])
+	assert.Equal(t, int8(-1), values["delta"])
+	assert.Equal(t, "hello", values["note"])
+	assert.Equal(t, []byte{0xca, 0xfe}, values["5"])
+}
+
+func TestAbiDecodeLogs(t *testing.T) {
+	t.Parallel()
+
+	abi, err := NewABIFromList([]string{
+		"event Transfer(address indexed from, address indexed to, uint256 value)",
+		"event Approval(address indexed owner, address indexed spender, uint256 value)",
+		"event Deposited(address indexed account, uint64 amount) anonymous",
+	})
+	require.NoError(t, err)
+	assert.True(t, abi.Events["Deposited"].Anonymous)
+
+	owner := leftPad(BytesToAddress([]byte{0x0a}).Bytes(), 32)
+	spender := leftPad(BytesToAddress([]byte{0x0b}).Bytes(), 32)
+	value, err := Encode(map[string]interface{}{"value": big.NewInt(7)}, mustNewType(t, "tuple(uint256 value)"))
+	require.NoError(t, err)
+	amount, err := Encode(map[string]interface{}{"amount": uint64(9)}, mustNewType(t, "tuple(uint64 amount)"))
+	require.NoError(t, err)
+
+	logs := []ContractLogInfo{
+		{Topics: [][]byte{abi.Events["Approval"].ID().Bytes(), owner, spender}, Data: value},
+		{Topics: [][]byte{make([]byte, 32), owner}, Data: value},
+		{Topics: [][]byte{owner}, Data: amount},
+		{Topics: [][]byte{abi.Events["Transfer"].ID().Bytes(), spender, owner}, Data: value},
+	}
+
+	decoded, err := abi.DecodeLogs(logs)
+	require.NoError(t, err)
+	require.Len(t, decoded, 3)
+	assert.Equal(t, "Approval", decoded[0].Event.Name)
+	assert.Equal(t, "Deposited", decoded[1].Event.Name)
+	assert.Equal(t, uint64(9), decoded[1].Values["amount"])
+	assert.Equal(t, "Transfer", decoded[2].Event.Name)
+	assert.Equal(t, logs[3].Topics, decoded[2].Log.Topics)
+
+	var deposit struct {
+		Account Address
+		Amount  uint64
+	}
+	require.NoError(t, decoded[1].DecodeStruct(&deposit))
+	assert.Equal(t, BytesToAddress([]byte{0x0a}), deposit.Account)
+	assert.Equal(t, uint64(9), deposit.Amount)
+
+	// A log with a single topic is decoded by the anonymous event, as the logs of anonymous events can't be told apart
+	decoded, err = abi.DecodeLogs([]ContractLogInfo{{Topics: [][]byte{make([]byte, 32)}, Data: value}})
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	assert.Equal(t, uint64(7), decoded[0].Values["amount"])
+
+	// A log which matches an event by its topic but can't be decoded
+	_, err = abi.DecodeLogs([]ContractLogInfo{{Topics: logs[0].Topics, Data: []byte{0x01}}})
+	require.ErrorContains(t, err, "failed to decode log 0")
+}
+
+func TestAbiDecodeLogsERC721Transfer(t *testing.T) {
+	t.Parallel()
+
+	erc20, err := NewABIFromList([]string{"event Transfer(address indexed from, address indexed to, uint256 value)"})
+	require.NoError(t, err)
+	erc721, err := NewABIFromList([]string{"event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)"})
+	require.NoError(t, err)
+	require.Equal(t, erc20.Events["Transfer"].ID(), erc721.Events["Transfer"].ID())
+
+	from := leftPad(BytesToAddress([]byte{0x0a}).Bytes(), 32)
+	to := leftPad(BytesToAddress([]byte{0x0b}).Bytes(), 32)
+	value, err := Encode(map[string]interface{}{"value": big.NewInt(7)}, mustNewType(t, "tuple(uint256 value)"))
+	require.NoError(t, err)
+
+	// The logs of a ContractFunctionResult mix the logs of every contract the call touched
+	logs := []ContractLogInfo{
+		{Topics: [][]byte{erc721.Events["Transfer"].ID().Bytes(), from, to, leftPad([]byte{3}, 32)}},
+		{Topics: [][]byte{erc20.Events["Transfer"].ID().Bytes(), from, to}, Data: value},
+	}
+
+	decoded, err := erc20.DecodeLogs(logs)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	assert.Equal(t, logs[1].Topics, decoded[0].Log.Topics)
+	assert.Equal(t, big.NewInt(7), decoded[0].Values["value"])
+
+	decoded, err = erc721.DecodeLogs(logs)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	assert.Equal(t, logs[0].Topics, decoded[0].Log.Topics)
+	assert.Equal(t, big.NewInt(3), decoded[0].Values["tokenId"])
+}
+
+func mustNewType(t *testing.T, s string) *Type {
+	typ, err := NewType(s)
+	require.NoError(t, err)
+	return typ
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -75,6 +76,11 @@ func (q *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the query with the provided client
 func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *AccountBalanceQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountBalance, error) {
 	if client == nil {
 		return AccountBalance{}, errNoClientProvided
 	}
@@ -84,7 +90,7 @@ func (q *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
 		return AccountBalance{}, err
 	}
 
-	resp, err := q.Query.execute(client, q)
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 	if err != nil {
 		return AccountBalance{}, err
 	}
@@ -115,6 +121,12 @@ func (q *AccountBalanceQuery) SetMaxRetry(count int) *AccountBalanceQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this AccountBalanceQuery.
+func (q *AccountBalanceQuery) SetRetryPolicy(policy RetryPolicy) *AccountBalanceQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries. Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *AccountBalanceQuery) SetMaxBackoff(max time.Duration) *AccountBalanceQuery {
 	q.Query.SetMaxBackoff(max)
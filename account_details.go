@@ -0,0 +1,209 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// AccountDetails is the full state of an account returned from an AccountDetailsQuery. Unlike AccountInfo, it
+// includes the allowances granted by the account.
+type AccountDetails struct {
+	AccountID                     AccountID
+	ContractAccountID             string
+	IsDeleted                     bool
+	ProxyReceived                 Hbar
+	Key                           Key
+	Balance                       Hbar
+	ReceiverSigRequired           bool
+	ExpirationTime                time.Time
+	AutoRenewPeriod               time.Duration
+	TokenRelationships            []*TokenRelationship
+	AccountMemo                   string
+	OwnedNfts                     int64
+	MaxAutomaticTokenAssociations int32
+	AliasKey                      *PublicKey
+	LedgerID                      LedgerID
+	// HbarAllowances are the hbar allowances granted by the account, which is their owner
+	HbarAllowances []HbarAllowance
+	// NftAllowances are the allowances for all the NFTs of a token granted by the account, which is their owner
+	NftAllowances []TokenNftAllowance
+	// TokenAllowances are the fungible token allowances granted by the account, which is their owner
+	TokenAllowances []TokenAllowance
+}
+
+func _AccountDetailsFromProtobuf(pb *services.GetAccountDetailsResponse_AccountDetails) (AccountDetails, error) {
+	if pb == nil {
+		return AccountDetails{}, errParameterNull
+	}
+
+	var key Key
+	if pb.Key != nil {
+		var err error
+		key, err = _KeyFromProtobuf(pb.Key)
+		if err != nil {
+			return AccountDetails{}, err
+		}
+	}
+
+	accountID := AccountID{}
+	if pb.AccountId != nil {
+		accountID = *_AccountIDFromProtobuf(pb.AccountId)
+	}
+
+	var alias *PublicKey
+	if len(pb.Alias) != 0 {
+		pbKey := services.Key{}
+		_ = protobuf.Unmarshal(pb.Alias, &pbKey)
+		initialKey, _ := _KeyFromProtobuf(&pbKey)
+		switch t2 := initialKey.(type) { //nolint
+		case PublicKey:
+			alias = &t2
+		}
+	}
+
+	var tokenRelationships []*TokenRelationship
+	if pb.TokenRelationships != nil {
+		tokenRelationships = _TokenRelationshipsFromProtobuf(pb.TokenRelationships)
+	}
+
+	hbarAllowances := make([]HbarAllowance, 0, len(pb.GrantedCryptoAllowances))
+	for _, allowance := range pb.GrantedCryptoAllowances {
+		hbarAllowances = append(hbarAllowances, HbarAllowance{
+			OwnerAccountID:   _AccountIDFromProtobuf(pb.AccountId),
+			SpenderAccountID: _AccountIDFromProtobuf(allowance.Spender),
+			Amount:           allowance.Amount,
+		})
+	}
+
+	nftAllowances := make([]TokenNftAllowance, 0, len(pb.GrantedNftAllowances))
+	for _, allowance := range pb.GrantedNftAllowances {
+		nftAllowances = append(nftAllowances, TokenNftAllowance{
+			TokenID:          _TokenIDFromProtobuf(allowance.TokenId),
+			OwnerAccountID:   _AccountIDFromProtobuf(pb.AccountId),
+			SpenderAccountID: _AccountIDFromProtobuf(allowance.Spender),
+			AllSerials:       true,
+		})
+	}
+
+	tokenAllowances := make([]TokenAllowance, 0, len(pb.GrantedTokenAllowances))
+	for _, allowance := range pb.GrantedTokenAllowances {
+		tokenAllowances = append(tokenAllowances, TokenAllowance{
+			TokenID:          _TokenIDFromProtobuf(allowance.TokenId),
+			OwnerAccountID:   _AccountIDFromProtobuf(pb.AccountId),
+			SpenderAccountID: _AccountIDFromProtobuf(allowance.Spender),
+			Amount:           allowance.Amount,
+		})
+	}
+
+	return AccountDetails{
+		AccountID:                     accountID,
+		ContractAccountID:             pb.ContractAccountId,
+		IsDeleted:                     pb.Deleted,
+		ProxyReceived:                 HbarFromTinybar(pb.ProxyReceived),
+		Key:                           key,
+		Balance:                       HbarFromTinybar(int64(pb.Balance)),
+		ReceiverSigRequired:           pb.ReceiverSigRequired,
+		ExpirationTime:                _TimeFromProtobuf(pb.ExpirationTime),
+		AutoRenewPeriod:               _DurationFromProtobuf(pb.AutoRenewPeriod),
+		TokenRelationships:            tokenRelationships,
+		AccountMemo:                   pb.Memo,
+		OwnedNfts:                     pb.OwnedNfts,
+		MaxAutomaticTokenAssociations: pb.MaxAutomaticTokenAssociations,
+		AliasKey:                      alias,
+		LedgerID:                      LedgerID{pb.LedgerId},
+		HbarAllowances:                hbarAllowances,
+		NftAllowances:                 nftAllowances,
+		TokenAllowances:               tokenAllowances,
+	}, nil
+}
+
+func (details AccountDetails) _ToProtobuf() *services.GetAccountDetailsResponse_AccountDetails {
+	var alias []byte
+	if details.AliasKey != nil {
+		alias, _ = protobuf.Marshal(details.AliasKey._ToProtoKey())
+	}
+
+	body := &services.GetAccountDetailsResponse_AccountDetails{
+		AccountId:                     details.AccountID._ToProtobuf(),
+		ContractAccountId:             details.ContractAccountID,
+		Deleted:                       details.IsDeleted,
+		ProxyReceived:                 details.ProxyReceived.tinybar,
+		Balance:                       uint64(details.Balance.tinybar),
+		ReceiverSigRequired:           details.ReceiverSigRequired,
+		ExpirationTime:                _TimeToProtobuf(details.ExpirationTime),
+		AutoRenewPeriod:               _DurationToProtobuf(details.AutoRenewPeriod),
+		Memo:                          details.AccountMemo,
+		OwnedNfts:                     details.OwnedNfts,
+		MaxAutomaticTokenAssociations: details.MaxAutomaticTokenAssociations,
+		Alias:                         alias,
+		LedgerId:                      details.LedgerID.ToBytes(),
+	}
+
+	if details.Key != nil {
+		body.Key = details.Key._ToProtoKey()
+	}
+
+	for _, relationship := range details.TokenRelationships {
+		body.TokenRelationships = append(body.TokenRelationships, relationship._ToProtobuf())
+	}
+
+	for _, allowance := range details.HbarAllowances {
+		granted := &services.GrantedCryptoAllowance{Amount: allowance.Amount}
+		if allowance.SpenderAccountID != nil {
+			granted.Spender = allowance.SpenderAccountID._ToProtobuf()
+		}
+		body.GrantedCryptoAllowances = append(body.GrantedCryptoAllowances, granted)
+	}
+
+	for _, allowance := range details.NftAllowances {
+		granted := &services.GrantedNftAllowance{}
+		if allowance.TokenID != nil {
+			granted.TokenId = allowance.TokenID._ToProtobuf()
+		}
+		if allowance.SpenderAccountID != nil {
+			granted.Spender = allowance.SpenderAccountID._ToProtobuf()
+		}
+		body.GrantedNftAllowances = append(body.GrantedNftAllowances, granted)
+	}
+
+	for _, allowance := range details.TokenAllowances {
+		granted := &services.GrantedTokenAllowance{Amount: allowance.Amount}
+		if allowance.TokenID != nil {
+			granted.TokenId = allowance.TokenID._ToProtobuf()
+		}
+		if allowance.SpenderAccountID != nil {
+			granted.Spender = allowance.SpenderAccountID._ToProtobuf()
+		}
+		body.GrantedTokenAllowances = append(body.GrantedTokenAllowances, granted)
+	}
+
+	return body
+}
+
+// ToBytes returns the serialized bytes of an AccountDetails
+func (details AccountDetails) ToBytes() []byte {
+	data, err := protobuf.Marshal(details._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+// AccountDetailsFromBytes returns an AccountDetails from byte array
+func AccountDetailsFromBytes(data []byte) (AccountDetails, error) {
+	if data == nil {
+		return AccountDetails{}, errByteArrayNull
+	}
+	pb := services.GetAccountDetailsResponse_AccountDetails{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return AccountDetails{}, err
+	}
+
+	return _AccountDetailsFromProtobuf(&pb)
+}
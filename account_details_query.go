@@ -0,0 +1,171 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// AccountDetailsQuery
+// Get all the information about an account, including the balance and the allowances it granted. This does not get
+// the list of account records.
+//
+// Only privileged accounts, such as the system admin and treasury accounts, may pay for this query; other payers are
+// answered with NOT_SUPPORTED.
+type AccountDetailsQuery struct {
+	Query
+	accountID *AccountID
+}
+
+// NewAccountDetailsQuery
+// Creates an AccountDetailsQuery which retrieves all the information about an account, including the balance and the
+// allowances it granted.
+func NewAccountDetailsQuery() *AccountDetailsQuery {
+	header := services.QueryHeader{}
+	return &AccountDetailsQuery{
+		Query: _NewQuery(true, &header),
+	}
+}
+
+func (q *AccountDetailsQuery) GetCost(client *Client) (Hbar, error) {
+	return q.Query.getCost(client, q)
+}
+
+// Execute executes the Query with the provided client
+func (q *AccountDetailsQuery) Execute(client *Client) (AccountDetails, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *AccountDetailsQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountDetails, error) {
+	resp, err := q.executeWithContext(ctx, client, q)
+
+	if err != nil {
+		return AccountDetails{}, err
+	}
+
+	return _AccountDetailsFromProtobuf(resp.GetAccountDetails().GetAccountDetails())
+}
+
+// SetGrpcDeadline When execution is attempted, a single attempt will timeout when this deadline is reached. (The SDK may subsequently retry the execution.)
+func (q *AccountDetailsQuery) SetGrpcDeadline(deadline *time.Duration) *AccountDetailsQuery {
+	q.Query.SetGrpcDeadline(deadline)
+	return q
+}
+
+// SetAccountID sets the AccountID for this AccountDetailsQuery.
+func (q *AccountDetailsQuery) SetAccountID(accountID AccountID) *AccountDetailsQuery {
+	q.accountID = &accountID
+	return q
+}
+
+// GetAccountID returns the AccountID for this AccountDetailsQuery.
+func (q *AccountDetailsQuery) GetAccountID() AccountID {
+	if q.accountID == nil {
+		return AccountID{}
+	}
+
+	return *q.accountID
+}
+
+// SetNodeAccountIDs sets the _Node AccountID for this AccountDetailsQuery.
+func (q *AccountDetailsQuery) SetNodeAccountIDs(accountID []AccountID) *AccountDetailsQuery {
+	q.Query.SetNodeAccountIDs(accountID)
+	return q
+}
+
+// SetQueryPayment sets the Hbar payment to pay the _Node a fee for handling this query
+func (q *AccountDetailsQuery) SetQueryPayment(queryPayment Hbar) *AccountDetailsQuery {
+	q.Query.SetQueryPayment(queryPayment)
+	return q
+}
+
+// SetMaxQueryPayment sets the maximum payment allowable for this query.
+func (q *AccountDetailsQuery) SetMaxQueryPayment(queryMaxPayment Hbar) *AccountDetailsQuery {
+	q.Query.SetMaxQueryPayment(queryMaxPayment)
+	return q
+}
+
+// SetMaxRetry sets the max number of errors before execution will fail.
+func (q *AccountDetailsQuery) SetMaxRetry(count int) *AccountDetailsQuery {
+	q.Query.SetMaxRetry(count)
+	return q
+}
+
+// SetRetryPolicy overrides the client's retry policy for this AccountDetailsQuery.
+func (q *AccountDetailsQuery) SetRetryPolicy(policy RetryPolicy) *AccountDetailsQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
+// SetMaxBackoff The maximum amount of time to wait between retries. Every retry attempt will increase the wait time exponentially until it reaches this time.
+func (q *AccountDetailsQuery) SetMaxBackoff(max time.Duration) *AccountDetailsQuery {
+	q.Query.SetMaxBackoff(max)
+	return q
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (q *AccountDetailsQuery) SetMinBackoff(min time.Duration) *AccountDetailsQuery {
+	q.Query.SetMinBackoff(min)
+	return q
+}
+
+// SetPaymentTransactionID assigns the payment transaction id.
+func (q *AccountDetailsQuery) SetPaymentTransactionID(transactionID TransactionID) *AccountDetailsQuery {
+	q.Query.SetPaymentTransactionID(transactionID)
+	return q
+}
+
+func (q *AccountDetailsQuery) SetLogLevel(level LogLevel) *AccountDetailsQuery {
+	q.Query.SetLogLevel(level)
+	return q
+}
+
+// ---------- Parent functions specific implementation ----------
+
+func (q *AccountDetailsQuery) getMethod(channel *_Channel) _Method {
+	return _Method{
+		query: channel._GetNetwork().GetAccountDetails,
+	}
+}
+
+func (q *AccountDetailsQuery) getName() string {
+	return "AccountDetailsQuery"
+}
+
+func (q *AccountDetailsQuery) buildQuery() *services.Query {
+	pbQuery := services.Query_AccountDetails{
+		AccountDetails: &services.GetAccountDetailsQuery{
+			Header: q.pbHeader,
+		},
+	}
+
+	if q.accountID != nil {
+		pbQuery.AccountDetails.AccountId = q.accountID._ToProtobuf()
+	}
+
+	return &services.Query{
+		Query: &pbQuery,
+	}
+}
+
+func (q *AccountDetailsQuery) validateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	if q.accountID != nil {
+		if err := q.accountID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (q *AccountDetailsQuery) getQueryResponse(response *services.Response) queryResponse {
+	return response.GetAccountDetails()
+}
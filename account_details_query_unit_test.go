@@ -0,0 +1,186 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockAccountDetails(key PrivateKey) *services.GetAccountDetailsResponse_AccountDetails {
+	owner := &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}}
+	spender := &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1801}}
+	token := &services.TokenID{TokenNum: 5}
+
+	return &services.GetAccountDetailsResponse_AccountDetails{
+		AccountId:               owner,
+		Key:                     key.PublicKey()._ToProtoKey(),
+		Balance:                 100,
+		ExpirationTime:          &services.Timestamp{Seconds: 1_700_000_000},
+		AutoRenewPeriod:         &services.Duration{Seconds: 7_776_000},
+		Memo:                    "details",
+		OwnedNfts:               2,
+		LedgerId:                []byte{0},
+		GrantedCryptoAllowances: []*services.GrantedCryptoAllowance{{Spender: spender, Amount: 10}},
+		GrantedNftAllowances:    []*services.GrantedNftAllowance{{TokenId: token, Spender: spender}},
+		GrantedTokenAllowances:  []*services.GrantedTokenAllowance{{TokenId: token, Spender: spender, Amount: 20}},
+	}
+}
+
+func TestUnitAccountDetailsFromProtobuf(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	details, err := _AccountDetailsFromProtobuf(_MockAccountDetails(key))
+	require.NoError(t, err)
+
+	owner := AccountID{Account: 1800}
+	spender := AccountID{Account: 1801}
+	token := TokenID{Token: 5}
+
+	assert.Equal(t, owner, details.AccountID)
+	assert.Equal(t, key.PublicKey().String(), details.Key.String())
+	assert.Equal(t, HbarFromTinybar(100), details.Balance)
+	assert.Equal(t, time.Unix(1_700_000_000, 0), details.ExpirationTime)
+	assert.Equal(t, 90*24*time.Hour, details.AutoRenewPeriod)
+	assert.Equal(t, "details", details.AccountMemo)
+	assert.Equal(t, int64(2), details.OwnedNfts)
+	assert.Equal(t, []HbarAllowance{NewHbarAllowance(owner, spender, 10)}, details.HbarAllowances)
+	assert.Equal(t, []TokenNftAllowance{{TokenID: &token, OwnerAccountID: &owner, SpenderAccountID: &spender, AllSerials: true}}, details.NftAllowances)
+	assert.Equal(t, []TokenAllowance{NewTokenAllowance(token, owner, spender, 20)}, details.TokenAllowances)
+
+	fromBytes, err := AccountDetailsFromBytes(details.ToBytes())
+	require.NoError(t, err)
+	assert.Equal(t, details.HbarAllowances, fromBytes.HbarAllowances)
+	assert.Equal(t, details.NftAllowances, fromBytes.NftAllowances)
+	assert.Equal(t, details.TokenAllowances, fromBytes.TokenAllowances)
+	assert.Equal(t, details.Key.String(), fromBytes.Key.String())
+	assert.Equal(t, details.ExpirationTime, fromBytes.ExpirationTime)
+
+	_, err = AccountDetailsFromBytes(nil)
+	assert.ErrorIs(t, err, errByteArrayNull)
+}
+
+func TestUnitAccountDetailsQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.NoError(t, err)
+	client.SetAutoValidateChecksums(true)
+
+	accountID, err := AccountIDFromString("0.0.123-esxsf")
+	require.NoError(t, err)
+	err = NewAccountDetailsQuery().SetAccountID(accountID).validateNetworkOnIDs(client)
+	require.NoError(t, err)
+
+	accountID, err = AccountIDFromString("0.0.123-rmkykd")
+	require.NoError(t, err)
+	err = NewAccountDetailsQuery().SetAccountID(accountID).validateNetworkOnIDs(client)
+	assert.EqualError(t, err, "network mismatch or wrong checksum given, given checksum: rmkykd, correct checksum esxsf, network: testnet")
+}
+
+func TestUnitAccountDetailsQueryGet(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Minute
+	transactionID := TransactionIDGenerate(AccountID{Account: 324})
+	query := NewAccountDetailsQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		SetQueryPayment(NewHbar(2)).
+		SetMaxQueryPayment(NewHbar(10)).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetMaxRetry(5).
+		SetMaxBackoff(10 * time.Second).
+		SetMinBackoff(time.Second).
+		SetPaymentTransactionID(transactionID).
+		SetGrpcDeadline(&deadline)
+
+	require.Equal(t, AccountID{Account: 1800}, query.GetAccountID())
+	require.Equal(t, NewHbar(2), query.GetQueryPayment())
+	require.Equal(t, NewHbar(10), query.GetMaxQueryPayment())
+	require.Equal(t, []AccountID{{Account: 3}}, query.GetNodeAccountIDs())
+	require.Equal(t, 5, query.GetMaxRetryCount())
+	require.Equal(t, 10*time.Second, query.GetMaxBackoff())
+	require.Equal(t, time.Second, query.GetMinBackoff())
+	require.Equal(t, transactionID, query.GetPaymentTransactionID())
+	require.Equal(t, &deadline, query.GetGrpcDeadline())
+	require.Equal(t, "AccountDetailsQuery", query.getName())
+
+	require.Equal(t, AccountID{}, NewAccountDetailsQuery().GetAccountID())
+}
+
+func TestUnitAccountDetailsQueryMock(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	call := func(request *services.Query) *services.Response {
+		query := request.GetAccountDetails()
+		require.Equal(t, int64(1800), query.GetAccountId().GetAccountNum())
+
+		response := &services.GetAccountDetailsResponse{
+			Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY, Cost: 2},
+		}
+		if query.GetHeader().GetResponseType() == services.ResponseType_COST_ANSWER {
+			response.Header.ResponseType = services.ResponseType_COST_ANSWER
+		} else {
+			response.AccountDetails = _MockAccountDetails(key)
+		}
+
+		return &services.Response{Response: &services.Response_AccountDetails{AccountDetails: response}}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call, call, call}})
+	defer server.Close()
+
+	query := NewAccountDetailsQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetMaxQueryPayment(NewHbar(1))
+
+	cost, err := query.GetCost(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(2), cost)
+
+	details, err := query.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 1800}, details.AccountID)
+	require.Len(t, details.HbarAllowances, 1)
+	require.Len(t, details.NftAllowances, 1)
+	require.Len(t, details.TokenAllowances, 1)
+}
+
+func TestUnitAccountDetailsQueryNotSupported(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_AccountDetails{
+				AccountDetails: &services.GetAccountDetailsResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_NOT_SUPPORTED, ResponseType: services.ResponseType_ANSWER_ONLY},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, err := NewAccountDetailsQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetQueryPayment(NewHbar(1)).
+		Execute(client)
+	require.ErrorContains(t, err, "NOT_SUPPORTED")
+}
@@ -2,7 +2,8 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
-// AccountInfoFlowVerifySignature Verifies signature using AccountInfoQuery
+// AccountInfoFlowVerifySignature Verifies signature using AccountInfoQuery. If the account key is a KeyList, the
+// signature must satisfy it on its own.
 func AccountInfoFlowVerifySignature(client *Client, accountID AccountID, message []byte, signature []byte) (bool, error) {
 	info, err := NewAccountInfoQuery().
 		SetAccountID(accountID).
@@ -12,14 +13,13 @@ func AccountInfoFlowVerifySignature(client *Client, accountID AccountID, message
 		return false, err
 	}
 
-	if key, ok := info.Key.(PublicKey); ok {
-		return key.Verify(message, signature), nil
-	}
-
-	return false, nil
+	return _EvaluateKey(info.Key, func(key PublicKey) bool {
+		return key.Verify(message, signature)
+	}).Satisfied, nil
 }
 
-// AccountInfoFlowVerifyTransaction Verifies transaction using AccountInfoQuery
+// AccountInfoFlowVerifyTransaction Verifies transaction using AccountInfoQuery. The account key, including a KeyList
+// with thresholds and nested lists, must be satisfied by valid signatures on the transaction.
 func AccountInfoFlowVerifyTransaction(client *Client, accountID AccountID, tx TransactionInterface, _ []byte) (bool, error) {
 	info, err := NewAccountInfoQuery().
 		SetAccountID(accountID).
@@ -29,9 +29,7 @@ func AccountInfoFlowVerifyTransaction(client *Client, accountID AccountID, tx Tr
 		return false, err
 	}
 
-	if key, ok := info.Key.(PublicKey); ok {
-		return key.VerifyTransaction(tx), nil
-	}
-
-	return false, nil
+	return _EvaluateKey(info.Key, func(key PublicKey) bool {
+		return key.VerifyTransaction(tx)
+	}).Satisfied, nil
 }
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -32,7 +33,12 @@ func (q *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
-	resp, err := q.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *AccountInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountInfo, error) {
+	resp, err := q.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return AccountInfo{}, err
@@ -86,6 +92,12 @@ func (q *AccountInfoQuery) SetMaxRetry(count int) *AccountInfoQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this AccountInfoQuery.
+func (q *AccountInfoQuery) SetRetryPolicy(policy RetryPolicy) *AccountInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries. Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *AccountInfoQuery) SetMaxBackoff(max time.Duration) *AccountInfoQuery {
 	q.Query.SetMaxBackoff(max)
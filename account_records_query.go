@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -54,7 +55,12 @@ func (q *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *AccountRecordsQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TransactionRecord, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 	records := make([]TransactionRecord, 0)
 
 	if err != nil {
@@ -93,6 +99,12 @@ func (q *AccountRecordsQuery) SetMaxRetry(count int) *AccountRecordsQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this AccountRecordsQuery.
+func (q *AccountRecordsQuery) SetRetryPolicy(policy RetryPolicy) *AccountRecordsQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *AccountRecordsQuery) SetMaxBackoff(max time.Duration) *AccountRecordsQuery {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -54,7 +55,12 @@ func (q *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *AccountStakersQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]Transfer, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return []Transfer{}, err
@@ -104,6 +110,12 @@ func (q *AccountStakersQuery) SetMaxRetry(count int) *AccountStakersQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this AccountStakersQuery.
+func (q *AccountStakersQuery) SetRetryPolicy(policy RetryPolicy) *AccountStakersQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *AccountStakersQuery) SetMaxBackoff(max time.Duration) *AccountStakersQuery {
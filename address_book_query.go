@@ -92,6 +92,11 @@ func (q *AddressBookQuery) build() *mirror.AddressBookQuery {
 
 // Execute executes the Query with the provided client
 func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *AddressBookQuery) ExecuteWithContext(parentCtx context.Context, client *Client) (NodeAddressBook, error) {
 	var cancel func()
 	var ctx context.Context
 	var subClientError error
@@ -118,12 +123,23 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 			if err != nil {
 				cancel()
 
+				if parentCtx.Err() != nil {
+					subClientError = ErrContextDone{Err: parentCtx.Err()}
+					break
+				}
+
 				if grpcErr, ok := status.FromError(err); ok { // nolint
 					if q.attempt < q.maxAttempts {
 						subClient = nil
 
 						delay := math.Min(250.0*math.Pow(2.0, float64(q.attempt)), 8000)
-						time.Sleep(time.Duration(delay) * time.Millisecond)
+						select {
+						case <-time.After(time.Duration(delay) * time.Millisecond):
+						case <-parentCtx.Done():
+							subClientError = ErrContextDone{Err: parentCtx.Err()}
+							ch <- 1
+							return
+						}
 						q.attempt++
 					} else {
 						subClientError = grpcErr.Err()
@@ -138,7 +154,14 @@ func (q *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
 			}
 
 			if subClient == nil {
-				ctx, cancel = context.WithCancel(client.networkUpdateContext)
+				// The subscription ends when either the caller's context or the client's network is done
+				var cancelSubscription context.CancelFunc
+				ctx, cancelSubscription = context.WithCancel(parentCtx)
+				stop := context.AfterFunc(client.networkUpdateContext, cancelSubscription)
+				cancel = func() {
+					stop()
+					cancelSubscription()
+				}
 
 				subClient, err = (*channel).GetNodes(ctx, pb)
 				if err != nil {
@@ -2,6 +2,11 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import (
+	"strconv"
+	"strings"
+)
+
 var hardenedBit uint32 = 0x80000000
 
 // Harden the index
@@ -13,3 +18,52 @@ func ToHardenedIndex(index uint32) uint32 {
 func IsHardenedIndex(index uint32) bool {
 	return (index & hardenedBit) != 0
 }
+
+// _ParseDerivationPath converts a BIP-32 derivation path such as `m/44'/3030'/0'/0/1` to its child indices. Hardened
+// indices are marked with `'`, `h` or `H`; the leading `m` is optional.
+func _ParseDerivationPath(derivationPath string) ([]uint32, error) {
+	path := strings.TrimSpace(derivationPath)
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "m"), "M")
+	if path == "" {
+		return []uint32{}, nil
+	}
+
+	path = strings.TrimPrefix(path, "/")
+	segments := strings.Split(path, "/")
+	indices := make([]uint32, 0, len(segments))
+	for _, segment := range segments {
+		hardened := strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H")
+		if hardened {
+			segment = segment[:len(segment)-1]
+		}
+
+		// Indices must fit in 31 bits, the top bit marks hardened indices
+		index, err := strconv.ParseUint(segment, 10, 31)
+		if err != nil {
+			return nil, _NewErrBadKeyf("invalid derivation path %q", derivationPath)
+		}
+
+		if hardened {
+			indices = append(indices, ToHardenedIndex(uint32(index)))
+		} else {
+			indices = append(indices, uint32(index))
+		}
+	}
+
+	return indices, nil
+}
+
+// _FormatDerivationPath converts child indices back to a derivation path, marking hardened indices with `'`
+func _FormatDerivationPath(indices []uint32) string {
+	var builder strings.Builder
+	builder.WriteString("m")
+	for _, index := range indices {
+		builder.WriteString("/")
+		builder.WriteString(strconv.FormatUint(uint64(index&^hardenedBit), 10))
+		if IsHardenedIndex(index) {
+			builder.WriteString("'")
+		}
+	}
+
+	return builder.String()
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync/atomic"
 	"time"
 )
 
@@ -42,6 +43,10 @@ type Client struct {
 	maxBackoff time.Duration
 	minBackoff time.Duration
 
+	retryPolicy RetryPolicy
+
+	interceptors atomic.Value // *_Interceptors
+
 	requestTimeout             *time.Duration
 	defaultNetworkUpdatePeriod time.Duration
 	networkUpdateContext       context.Context
@@ -56,7 +61,7 @@ type _Operator struct {
 	accountID  AccountID
 	privateKey *PrivateKey
 	publicKey  PublicKey
-	signer     TransactionSigner
+	signer     Signer
 }
 
 var mainnetMirror = []string{"mainnet-public.mirrornode.hedera.com:443"}
@@ -125,6 +130,7 @@ func _NewClient(network _Network, mirrorNetwork []string, ledgerId *LedgerID, sh
 		maxAttempts:                     nil,
 		minBackoff:                      250 * time.Millisecond,
 		maxBackoff:                      8 * time.Second,
+		retryPolicy:                     NewDefaultRetryPolicy(),
 		defaultRegenerateTransactionIDs: true,
 		defaultNetworkUpdatePeriod:      24 * time.Hour,
 		networkUpdateContext:            ctx,
@@ -325,7 +331,7 @@ func clientFromConfig(jsonBytes []byte, shouldScheduleNetworkUpdate bool) (*Clie
 		accountID:  operatorID,
 		privateKey: &operatorKey,
 		publicKey:  operatorKey.PublicKey(),
-		signer:     operatorKey.Sign,
+		signer:     _NewTransactionSignerAdapter(operatorKey.PublicKey(), operatorKey.Sign),
 	}
 
 	client.operator = &operator
@@ -433,6 +439,55 @@ func (client *Client) GetMinBackoff() time.Duration {
 	return client.minBackoff
 }
 
+// SetRetryPolicy sets the policy that decides whether, when and where failed requests are retried.
+// Individual transactions and queries can override it with their own SetRetryPolicy.
+func (client *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	client.retryPolicy = policy
+	return client
+}
+
+// GetRetryPolicy returns the policy that decides whether, when and where failed requests are retried.
+func (client *Client) GetRetryPolicy() RetryPolicy {
+	if client.retryPolicy == nil {
+		return defaultRetryPolicy
+	}
+
+	return client.retryPolicy
+}
+
+// AddInterceptor registers an Interceptor that is notified about every attempt, response, backoff and unhealthy
+// node while transactions and queries are executed with this client.
+func (client *Client) AddInterceptor(interceptor Interceptor) *Client {
+	for {
+		current, _ := client.interceptors.Load().(*_Interceptors)
+
+		interceptors := _Interceptors{}
+		if current != nil {
+			interceptors = append(interceptors, *current...)
+		}
+		interceptors = append(interceptors, interceptor)
+
+		var swapped bool
+		if current == nil {
+			swapped = client.interceptors.CompareAndSwap(nil, &interceptors)
+		} else {
+			swapped = client.interceptors.CompareAndSwap(current, &interceptors)
+		}
+
+		if swapped {
+			return client
+		}
+	}
+}
+
+func (client *Client) _GetInterceptors() _Interceptors {
+	if interceptors, ok := client.interceptors.Load().(*_Interceptors); ok {
+		return *interceptors
+	}
+
+	return nil
+}
+
 // SetMaxAttempts sets the maximum number of times to attempt a transaction or query.
 func (client *Client) SetMaxAttempts(max int) {
 	client.maxAttempts = &max
@@ -601,7 +656,7 @@ func (client *Client) SetOperator(accountID AccountID, privateKey PrivateKey) *C
 		accountID:  accountID,
 		privateKey: &privateKey,
 		publicKey:  privateKey.PublicKey(),
-		signer:     privateKey.Sign,
+		signer:     _NewTransactionSignerAdapter(privateKey.PublicKey(), privateKey.Sign),
 	}
 
 	return client
@@ -611,6 +666,26 @@ func (client *Client) SetOperator(accountID AccountID, privateKey PrivateKey) *C
 // transactions and queries built with the client, the account's PublicKey
 // and a callback that will be invoked when a transaction needs to be signed.
 func (client *Client) SetOperatorWith(accountID AccountID, publicKey PublicKey, signer TransactionSigner) *Client {
+	client.operator = &_Operator{
+		accountID:  accountID,
+		privateKey: nil,
+		publicKey:  publicKey,
+		signer:     _NewTransactionSignerAdapter(publicKey, signer),
+	}
+
+	return client
+}
+
+// SetOperatorWithSigner sets that account that will, by default, be paying for
+// transactions and queries built with the client, and the Signer that will be
+// invoked when a transaction or query payment needs to be signed. Signing errors
+// are returned from Execute, as is an error if signer is nil.
+func (client *Client) SetOperatorWithSigner(accountID AccountID, signer Signer) *Client {
+	var publicKey PublicKey
+	if signer != nil {
+		publicKey = signer.PublicKey()
+	}
+
 	client.operator = &_Operator{
 		accountID:  accountID,
 		privateKey: nil,
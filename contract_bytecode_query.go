@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -50,7 +51,12 @@ func (q *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *ContractBytecodeQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]byte, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return []byte{}, err
@@ -83,6 +89,12 @@ func (q *ContractBytecodeQuery) SetMaxRetry(count int) *ContractBytecodeQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this ContractBytecodeQuery.
+func (q *ContractBytecodeQuery) SetRetryPolicy(policy RetryPolicy) *ContractBytecodeQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *ContractBytecodeQuery) SetMaxBackoff(max time.Duration) *ContractBytecodeQuery {
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -119,7 +120,12 @@ func (q *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *ContractCallQuery) Execute(client *Client) (ContractFunctionResult, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *ContractCallQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractFunctionResult, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return ContractFunctionResult{}, err
@@ -152,6 +158,12 @@ func (q *ContractCallQuery) SetMaxRetry(count int) *ContractCallQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this ContractCallQuery.
+func (q *ContractCallQuery) SetRetryPolicy(policy RetryPolicy) *ContractCallQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *ContractCallQuery) SetMaxBackoff(max time.Duration) *ContractCallQuery {
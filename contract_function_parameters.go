@@ -1798,6 +1798,69 @@ func (contract *ContractFunctionParameters) AddStringArray(value []string) *Cont
 	return contract
 }
 
+// AddValue adds a parameter of any ABI type, such as a tuple (Solidity struct), a nested or fixed-size array or an
+// integer of any width. The value is encoded like Encode does: tuples from structs (with `abi` field tags), maps or
+// slices, arrays from slices or arrays, and integers from Go integers, *big.Int or decimal/hex strings.
+func (contract *ContractFunctionParameters) AddValue(abiType *Type, value interface{}) (*ContractFunctionParameters, error) {
+	if abiType == nil {
+		return contract, errors.New("abi type is required")
+	}
+
+	encoded, err := Encode(value, abiType)
+	if err != nil {
+		return contract, err
+	}
+
+	if abiType.isDynamicType() {
+		contract.arguments = append(contract.arguments, Argument{
+			value:   encoded,
+			dynamic: true,
+		})
+	} else {
+		// Static tuples and fixed-size arrays are encoded in place, one word after the other
+		for i := 0; i < len(encoded); i += 32 {
+			contract.arguments = append(contract.arguments, Argument{
+				value:   encoded[i : i+32],
+				dynamic: false,
+			})
+		}
+	}
+
+	contract.function._AddType(abiType)
+	return contract, nil
+}
+
+// AddValueString adds a parameter of the ABI type given as a Solidity type string, such as
+// "tuple(string name, uint16 age)[]" or "uint24[2][]". See AddValue for how the value is encoded.
+func (contract *ContractFunctionParameters) AddValueString(abiType string, value interface{}) (*ContractFunctionParameters, error) {
+	typ, err := NewType(abiType)
+	if err != nil {
+		return contract, err
+	}
+
+	return contract.AddValue(typ, value)
+}
+
+// AddTuple adds a tuple (Solidity struct) parameter with the given components, such as "(string name, uint16 age)".
+// The value is a struct, a map keyed by the component names or a slice of the components in order.
+func (contract *ContractFunctionParameters) AddTuple(components string, value interface{}) (*ContractFunctionParameters, error) {
+	components = strings.TrimSpace(components)
+	if strings.HasPrefix(components, "(") {
+		components = "tuple" + components
+	}
+
+	typ, err := NewType(components)
+	if err != nil {
+		return contract, err
+	}
+
+	if typ.Kind() != KindTuple {
+		return contract, fmt.Errorf("type '%s' is not a tuple", typ)
+	}
+
+	return contract.AddValue(typ, value)
+}
+
 func (contract *ContractFunctionParameters) _Build(functionName *string) []byte {
 	length := uint64(0)
 
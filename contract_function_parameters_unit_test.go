@@ -0,0 +1,109 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitContractFunctionParametersAddValueSolidityExamples(t *testing.T) {
+	t.Parallel()
+
+	// The examples of the Solidity ABI specification
+	name := "f"
+	params := NewContractFunctionParameters().AddUint256BigInt(big.NewInt(0x123))
+	_, err := params.AddValueString("uint32[]", []uint32{0x456, 0x789})
+	require.NoError(t, err)
+	_, err = params.AddValueString("bytes10", [10]byte{'1', '2', '3', '4', '5', '6', '7', '8', '9', '0'})
+	require.NoError(t, err)
+	params.AddBytes([]byte("Hello, world!"))
+
+	assert.Equal(t, "8be65246"+
+		"0000000000000000000000000000000000000000000000000000000000000123"+
+		"0000000000000000000000000000000000000000000000000000000000000080"+
+		"3132333435363738393000000000000000000000000000000000000000000000"+
+		"00000000000000000000000000000000000000000000000000000000000000e0"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000456"+
+		"0000000000000000000000000000000000000000000000000000000000000789"+
+		"000000000000000000000000000000000000000000000000000000000000000d"+
+		"48656c6c6f2c20776f726c642100000000000000000000000000000000000000",
+		hex.EncodeToString(params._Build(&name)))
+	assert.Equal(t, "f(uint256,uint32[],bytes10,bytes)", params.function.String())
+
+	// A fixed-size array is encoded in place
+	name = "bar"
+	params, err = NewContractFunctionParameters().AddValueString("bytes3[2]", [2][3]byte{{'a', 'b', 'c'}, {'d', 'e', 'f'}})
+	require.NoError(t, err)
+	assert.Equal(t, "fce353f6"+
+		"6162630000000000000000000000000000000000000000000000000000000000"+
+		"6465660000000000000000000000000000000000000000000000000000000000",
+		hex.EncodeToString(params._Build(&name)))
+}
+
+func TestUnitContractFunctionParametersAddTuple(t *testing.T) {
+	t.Parallel()
+
+	type person struct {
+		Name string
+		Age  uint16
+	}
+
+	params, err := NewContractFunctionParameters().AddTuple("(string name, uint16 age)", person{Name: "alice", Age: 30})
+	require.NoError(t, err)
+	_, err = params.AddTuple("tuple(uint24 a, int8 b)", []interface{}{7, -1})
+	require.NoError(t, err)
+	_, err = params.AddValueString("uint24[2][]", [][2]uint32{{1, 2}, {3, 4}})
+	require.NoError(t, err)
+	_, err = params.AddValueString("tuple(string name, uint16 age)[]", []map[string]interface{}{{"name": "bob", "age": 40}})
+	require.NoError(t, err)
+	params.AddBool(true)
+
+	name := "register"
+	built := params._Build(&name)
+	assert.Equal(t, "register((string,uint16),(uint24,int8),uint24[2][],(string,uint16)[],bool)", params.function.String())
+
+	// The parameters are encoded as the ABI package encodes the arguments of the method
+	method, err := NewMethod("function register(tuple(string name, uint16 age) p, tuple(uint24 a, int8 b) q, uint24[2][] r, tuple(string name, uint16 age)[] s, bool t)")
+	require.NoError(t, err)
+	expected, err := method.Encode(map[string]interface{}{
+		"p": map[string]interface{}{"name": "alice", "age": uint16(30)},
+		"q": map[string]interface{}{"a": 7, "b": -1},
+		"r": [][2]uint32{{1, 2}, {3, 4}},
+		"s": []map[string]interface{}{{"name": "bob", "age": 40}},
+		"t": true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(expected), hex.EncodeToString(built))
+
+	decoded, err := Decode(method.Inputs, built[4:])
+	require.NoError(t, err)
+	assert.Equal(t, int8(-1), decoded.(map[string]interface{})["q"].(map[string]interface{})["b"])
+}
+
+func TestUnitContractFunctionParametersAddValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	params := NewContractFunctionParameters()
+	_, err := params.AddTuple("uint256", 1)
+	require.ErrorContains(t, err, "is not a tuple")
+	_, err = params.AddValueString("uint256[", 1)
+	require.Error(t, err)
+	_, err = params.AddValueString("bool", "yes")
+	require.Error(t, err)
+	_, err = params.AddValue(nil, 1)
+	require.Error(t, err)
+
+	// Failed parameters are not added
+	assert.Empty(t, params.arguments)
+	assert.False(t, strings.Contains(params.function.String(), "uint"))
+}
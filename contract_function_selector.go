@@ -3,6 +3,8 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"strings"
+
 	"golang.org/x/crypto/sha3"
 )
 
@@ -125,6 +127,15 @@ func (selector *ContractFunctionSelector) _AddParam(ty _Solidity) *ContractFunct
 	return selector
 }
 
+// _AddType adds a parameter of any ABI type to the selector, using the canonical form of the type which writes tuples
+// as their components in parentheses.
+func (selector *ContractFunctionSelector) _AddType(abiType *Type) *ContractFunctionSelector {
+	return selector._AddParam(_Solidity{
+		ty:    argument(strings.ReplaceAll(abiType.String(), "tuple", "")),
+		array: false,
+	})
+}
+
 // AddFunction adds a function parameter to the selector.
 func (selector *ContractFunctionSelector) AddFunction() *ContractFunctionSelector {
 	return selector._AddParam(_Solidity{
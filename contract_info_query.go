@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -52,7 +53,12 @@ func (q *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *ContractInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return ContractInfo{}, err
@@ -90,6 +96,12 @@ func (q *ContractInfoQuery) SetMaxRetry(count int) *ContractInfoQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this ContractInfoQuery.
+func (q *ContractInfoQuery) SetRetryPolicy(policy RetryPolicy) *ContractInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *ContractInfoQuery) SetMaxBackoff(max time.Duration) *ContractInfoQuery {
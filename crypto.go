@@ -343,64 +343,45 @@ func PrivateKeyFromSeedECDSAsecp256k1(seed []byte) (PrivateKey, error) {
 	}, nil
 }
 
+// PrivateKeyFromKeystore recovers a PrivateKey from an encrypted keystore encoded as a byte slice. Both Hedera
+// keystores and Ethereum V3 keystores (scrypt or pbkdf2) are supported.
 func PrivateKeyFromKeystore(ks []byte, passphrase string) (PrivateKey, error) {
-	key, err := _Ed25519PrivateKeyFromKeystore(ks, passphrase)
-	if err != nil {
-		return PrivateKey{}, err
-	}
-
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return _ParseKeystore(ks, passphrase)
 }
 
-// PrivateKeyReadKeystore recovers an _Ed25519PrivateKey from an encrypted _Keystore file.
+// PrivateKeyReadKeystore recovers a PrivateKey from an encrypted keystore file. Both Hedera keystores and Ethereum V3
+// keystores (scrypt or pbkdf2) are supported.
 func PrivateKeyReadKeystore(source io.Reader, passphrase string) (PrivateKey, error) {
-	key, err := _Ed25519PrivateKeyReadKeystore(source, passphrase)
+	keystoreBytes, err := io.ReadAll(source)
 	if err != nil {
 		return PrivateKey{}, err
 	}
 
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return _ParseKeystore(keystoreBytes, passphrase)
 }
 
 func PrivateKeyFromPem(bytes []byte, passphrase string) (PrivateKey, error) {
-	key, err := _Ed25519PrivateKeyFromPem(bytes, passphrase)
+	if key, found, err := _PrivateKeyFromPKCS8Pem(bytes, passphrase); found {
+		return key, err
+	}
+
+	key, err := _ECDSAPrivateKeyFromPem(bytes, passphrase)
 	if err != nil {
-		key, err := _ECDSAPrivateKeyFromPem(bytes, passphrase)
-		if err != nil {
-			return PrivateKey{}, err
-		}
-		return PrivateKey{
-			ecdsaPrivateKey: key,
-		}, nil
+		return PrivateKey{}, err
 	}
 
 	return PrivateKey{
-		ed25519PrivateKey: key,
+		ecdsaPrivateKey: key,
 	}, nil
 }
 
 func PrivateKeyReadPem(source io.Reader, passphrase string) (PrivateKey, error) {
-	// note: Passphrases are currently not supported, but included in the function definition to avoid breaking
-	// changes in the future.
-
-	key, err := _Ed25519PrivateKeyReadPem(source, passphrase)
+	pemFileBytes, err := io.ReadAll(source)
 	if err != nil {
-		key, err := _ECDSAPrivateKeyReadPem(source, passphrase)
-		if err != nil {
-			return PrivateKey{}, err
-		}
-		return PrivateKey{
-			ecdsaPrivateKey: key,
-		}, nil
+		return PrivateKey{}, err
 	}
 
-	return PrivateKey{
-		ed25519PrivateKey: key,
-	}, nil
+	return PrivateKeyFromPem(pemFileBytes, passphrase)
 }
 
 // The use of raw bytes for a Ed25519 public key is deprecated; use PublicKeyFromStringEd25519/ECDSA() instead.
@@ -496,7 +477,50 @@ func _DeriveECDSAChildKey(parentKey []byte, chainCode []byte, index uint32) ([]b
 	ki.Add(privKey.ToECDSA().D, il)
 	ki.Mod(ki, privKey.ToECDSA().Curve.Params().N)
 
-	return ki.Bytes(), ir, nil
+	return ki.FillBytes(make([]byte, 32)), ir, nil
+}
+
+// _DeriveECDSAPublicChildKey derives the compressed public key of a non-hardened child from the compressed public key
+// of its parent, as in BIP-32 CKDpub
+func _DeriveECDSAPublicChildKey(parentKey []byte, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	if IsHardenedIndex(index) {
+		return nil, nil, errors.New("hardened child keys cannot be derived from a public key")
+	}
+
+	publicKey, err := secp256k1.ParsePubKey(parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := hmac.New(sha512.New, chainCode)
+
+	input := make([]byte, 37)
+	copy(input, publicKey.SerializeCompressed())
+	binary.BigEndian.PutUint32(input[33:37], index)
+
+	if _, err := h.Write(input); err != nil {
+		return nil, nil, err
+	}
+
+	i := h.Sum(nil)
+
+	var il secp256k1.ModNScalar
+	if overflow := il.SetByteSlice(i[0:32]); overflow || il.IsZero() {
+		return nil, nil, fmt.Errorf("invalid child key at index %v", index)
+	}
+
+	var parent, tweak, child secp256k1.JacobianPoint
+	publicKey.AsJacobian(&parent)
+	secp256k1.ScalarBaseMultNonConst(&il, &tweak)
+	secp256k1.AddNonConst(&parent, &tweak, &child)
+
+	if (child.X.IsZero() && child.Y.IsZero()) || child.Z.IsZero() {
+		return nil, nil, fmt.Errorf("invalid child key at index %v", index)
+	}
+
+	child.ToAffine()
+
+	return secp256k1.NewPublicKey(&child.X, &child.Y).SerializeCompressed(), i[32:], nil
 }
 
 func _DeriveLegacyChildKey(parentKey []byte, index int64) ([]byte, error) {
@@ -734,20 +758,90 @@ func (pk PublicKey) BytesDer() []byte {
 	return []byte{}
 }
 
+// ToPem returns the PublicKey as a SubjectPublicKeyInfo PEM block.
+func (pk PublicKey) ToPem() []byte {
+	return _PemEncodePublicKey(pk.BytesDer())
+}
+
+// Keystore returns an encrypted Hedera keystore containing the PrivateKey.
 func (sk PrivateKey) Keystore(passphrase string) ([]byte, error) {
 	if sk.ed25519PrivateKey != nil {
 		return sk.ed25519PrivateKey._Keystore(passphrase)
 	}
 
-	return []byte{}, errors.New("only ed25519 keystore is supported right now")
+	if sk.ecdsaPrivateKey != nil {
+		return sk.ecdsaPrivateKey._Keystore(passphrase)
+	}
+
+	return []byte{}, errors.New("private key is empty")
 }
 
+// WriteKeystore writes an encrypted Hedera keystore containing the PrivateKey to the provided destination.
 func (sk PrivateKey) WriteKeystore(destination io.Writer, passphrase string) error {
 	if sk.ed25519PrivateKey != nil {
 		return sk.ed25519PrivateKey._WriteKeystore(destination, passphrase)
 	}
 
-	return errors.New("only writing ed25519 keystore is supported right now")
+	if sk.ecdsaPrivateKey != nil {
+		return sk.ecdsaPrivateKey._WriteKeystore(destination, passphrase)
+	}
+
+	return errors.New("private key is empty")
+}
+
+// EthereumKeystore returns an encrypted Ethereum V3 keystore containing the PrivateKey, as used by geth and MetaMask.
+// The passphrase is stretched with scrypt using geth's standard parameters. Only ECDSA secp256k1 keys are supported.
+func (sk PrivateKey) EthereumKeystore(passphrase string) ([]byte, error) {
+	if sk.ecdsaPrivateKey != nil {
+		return sk.ecdsaPrivateKey._EthereumKeystore(passphrase)
+	}
+
+	return []byte{}, errors.New("only ecdsa keys can be stored in an ethereum keystore")
+}
+
+// WriteEthereumKeystore writes an encrypted Ethereum V3 keystore containing the PrivateKey to the provided destination.
+// Only ECDSA secp256k1 keys are supported.
+func (sk PrivateKey) WriteEthereumKeystore(destination io.Writer, passphrase string) error {
+	keystore, err := sk.EthereumKeystore(passphrase)
+	if err != nil {
+		return err
+	}
+
+	_, err = destination.Write(keystore)
+
+	return err
+}
+
+// ToPem returns the PrivateKey as a PKCS#8 PEM block. If passphrase is not empty, the key is encrypted with PBES2
+// (PBKDF2-HMAC-SHA256 and AES-256-CBC) in an ENCRYPTED PRIVATE KEY block.
+func (sk PrivateKey) ToPem(passphrase string) ([]byte, error) {
+	if sk.ed25519PrivateKey != nil {
+		return _PemEncode(sk.ed25519PrivateKey._BytesDer(), passphrase)
+	}
+
+	if sk.ecdsaPrivateKey != nil {
+		der, err := sk.ecdsaPrivateKey._BytesPKCS8()
+		if err != nil {
+			return nil, err
+		}
+
+		return _PemEncode(der, passphrase)
+	}
+
+	return []byte{}, errors.New("private key is empty")
+}
+
+// WritePem writes the PrivateKey as a PKCS#8 PEM block to the provided destination, encrypting it if passphrase is
+// not empty.
+func (sk PrivateKey) WritePem(destination io.Writer, passphrase string) error {
+	pemBytes, err := sk.ToPem(passphrase)
+	if err != nil {
+		return err
+	}
+
+	_, err = destination.Write(pemBytes)
+
+	return err
 }
 
 // Sign signs the provided message with the Ed25519PrivateKey.
@@ -198,15 +198,6 @@ func _ECDSAPrivateKeyFromPem(bytes []byte, passphrase string) (*_ECDSAPrivateKey
 	return key, nil
 }
 
-func _ECDSAPrivateKeyReadPem(source io.Reader, passphrase string) (*_ECDSAPrivateKey, error) {
-	pemFileBytes, err := io.ReadAll(source)
-	if err != nil {
-		return &_ECDSAPrivateKey{}, err
-	}
-
-	return _ECDSAPrivateKeyFromPem(pemFileBytes, passphrase)
-}
-
 func (sk _ECDSAPrivateKey) _Sign(message []byte) []byte {
 	hash := Keccak256Hash(message)
 	return ecdsa.SignCompact(sk.keyData, hash.Bytes(), true)
@@ -274,6 +265,28 @@ func (sk _ECDSAPrivateKey) _BytesDer() []byte {
 	return derBytes
 }
 
+// _Keystore returns an encrypted Hedera _Keystore containing the DER encoded _ECDSAPrivateKey.
+func (sk _ECDSAPrivateKey) _Keystore(passphrase string) ([]byte, error) {
+	return _NewKeystore(sk._BytesDer(), passphrase)
+}
+
+// _WriteKeystore writes an encrypted Hedera _Keystore containing the _ECDSAPrivateKey to the provided destination.
+func (sk _ECDSAPrivateKey) _WriteKeystore(destination io.Writer, passphrase string) error {
+	keystore, err := sk._Keystore(passphrase)
+	if err != nil {
+		return err
+	}
+
+	_, err = destination.Write(keystore)
+
+	return err
+}
+
+// _EthereumKeystore returns an encrypted Ethereum V3 keystore containing the _ECDSAPrivateKey.
+func (sk _ECDSAPrivateKey) _EthereumKeystore(passphrase string) ([]byte, error) {
+	return _NewEthereumKeystore(&sk, passphrase, scryptN, scryptP)
+}
+
 func (sk _ECDSAPrivateKey) String() string {
 	return sk._StringRaw()
 }
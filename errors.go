@@ -5,6 +5,7 @@ package hiero
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	// "reflect"
 
@@ -26,12 +27,15 @@ var errFailedToDeserializeBytes = errors.New("failed to deserialize bytes")
 var errNoTransactionInBytes = errors.New("no transaction was found in bytes")
 var errTransactionRequiresSingleNodeAccountID = errors.New("`PrivateKey.SignTransaction()` requires `Transaction` to have a single _Node `AccountID` set")
 var errNoTransactions = errors.New("no transactions to execute")
+var errEmptySignature = errors.New("signer returned an empty signature")
+var errNilSigner = errors.New("signer is nil")
 var errByteArrayNull = errors.New("byte array can't be null")
 var errParameterNull = errors.New("the parameter can't be null")
 var errNetworkNameMissing = errors.New("can't derive checksum for ID without knowing which _Network the ID is for")
 var errChecksumMissing = errors.New("no checksum provided")
 var errLockedSlice = errors.New("slice is locked")
 var errNodeIsUnhealthy = errors.New("node is unhealthy")
+var errInvalidExchangeRate = errors.New("exchange rate must have positive hbar and cent equivalents")
 
 type ErrInvalidNodeAccountIDSet struct {
 	NodeAccountID AccountID
@@ -136,6 +140,23 @@ func (e ErrHederaRecordStatus) Error() string {
 	return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
 }
 
+// ErrContextDone is returned by the ExecuteWithContext family of methods if the provided context is cancelled or its
+// deadline passes before execution completes. Err is the context's error, so errors.Is(err, context.Canceled) and
+// errors.Is(err, context.DeadlineExceeded) work as expected.
+type ErrContextDone struct {
+	Err error
+}
+
+// Error() implements the Error interface
+func (e ErrContextDone) Error() string {
+	return fmt.Sprintf("execution stopped before completion: %s", e.Err)
+}
+
+// Unwrap returns the context's error
+func (e ErrContextDone) Unwrap() error {
+	return e.Err
+}
+
 // ErrLocalValidation is returned by TransactionBuilder.Build(*Client) and QueryBuilder.Execute(*Client)
 // if the constructed transaction or query fails local sanity checks.
 type ErrLocalValidation struct {
@@ -146,3 +167,156 @@ type ErrLocalValidation struct {
 func (e ErrLocalValidation) Error() string {
 	return e.message
 }
+
+// ErrMnemonicUnknownWord is returned when a word of a mnemonic is not in the wordlist of its language
+type ErrMnemonicUnknownWord struct {
+	// Index is the position of the word in the mnemonic, starting at 0
+	Index int
+	// Word is the unknown word
+	Word string
+	// Suggestions are the words of the wordlist closest to Word, nearest first
+	Suggestions []string
+}
+
+func (e ErrMnemonicUnknownWord) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("mnemonic word %d (%q) is not in the wordlist", e.Index+1, e.Word)
+	}
+
+	return fmt.Sprintf("mnemonic word %d (%q) is not in the wordlist; did you mean %q?", e.Index+1, e.Word, e.Suggestions[0])
+}
+
+// MnemonicWordSuggestion is a replacement for a word of a mnemonic which makes its checksum valid
+type MnemonicWordSuggestion struct {
+	// Index is the position of the word in the mnemonic, starting at 0
+	Index int
+	// Word is the word of the mnemonic which is probably wrong
+	Word string
+	// Replacement is the word which makes the checksum valid
+	Replacement string
+	// Distance is the edit distance between Word and Replacement
+	Distance int
+}
+
+// ErrMnemonicChecksum is returned when the words of a mnemonic are all in the wordlist but its checksum is invalid,
+// which usually means that a word was written down or typed wrong.
+type ErrMnemonicChecksum struct {
+	// Suggestions are the replacements of a single word, by a similar word, which make the checksum valid; the most
+	// similar come first
+	Suggestions []MnemonicWordSuggestion
+}
+
+func (e ErrMnemonicChecksum) Error() string {
+	if len(e.Suggestions) == 0 {
+		return "mnemonic checksum mismatch"
+	}
+
+	suggestion := e.Suggestions[0]
+	return fmt.Sprintf("mnemonic checksum mismatch; word %d (%q) is probably wrong, did you mean %q?",
+		suggestion.Index+1, suggestion.Word, suggestion.Replacement)
+}
+
+// TopicMessageViolation is the kind of integrity violation a TopicRunningHashVerifier detected
+type TopicMessageViolation int
+
+const (
+	// TopicMessageViolationSequenceGap means messages before this one were not received
+	TopicMessageViolationSequenceGap TopicMessageViolation = iota
+	// TopicMessageViolationOutOfOrder means the message was already received, or is older than the last one
+	TopicMessageViolationOutOfOrder
+	// TopicMessageViolationRunningHashMismatch means the running hash of the message isn't the one computed from the
+	// previous running hash and the message, so the message was altered or forged
+	TopicMessageViolationRunningHashMismatch
+	// TopicMessageViolationUnsupportedVersion means the running hash of the message has a version other than 3
+	TopicMessageViolationUnsupportedVersion
+	// TopicMessageViolationUnknownPayer means the payer of the message, which its running hash covers, is unknown
+	// because the message has no chunk info
+	TopicMessageViolationUnknownPayer
+)
+
+// String returns a description of the violation
+func (violation TopicMessageViolation) String() string {
+	switch violation {
+	case TopicMessageViolationSequenceGap:
+		return "sequence gap"
+	case TopicMessageViolationOutOfOrder:
+		return "message out of order"
+	case TopicMessageViolationRunningHashMismatch:
+		return "running hash mismatch"
+	case TopicMessageViolationUnsupportedVersion:
+		return "unsupported running hash version"
+	case TopicMessageViolationUnknownPayer:
+		return "unknown payer"
+	default:
+		return fmt.Sprintf("TopicMessageViolation(%d)", int(violation))
+	}
+}
+
+// ErrTopicMessageVerification is reported when a topic message received by a subscription fails running hash
+// verification.
+type ErrTopicMessageVerification struct {
+	Violation              TopicMessageViolation
+	TopicID                TopicID
+	SequenceNumber         uint64
+	ExpectedSequenceNumber uint64
+	ConsensusTimestamp     time.Time
+}
+
+func (e ErrTopicMessageVerification) Error() string {
+	return fmt.Sprintf("topic %s message %d (expected %d) failed verification: %s",
+		e.TopicID.String(), e.SequenceNumber, e.ExpectedSequenceNumber, e.Violation)
+}
+
+// ErrTopicMessageStreamOverflow ends a topic message stream with the TopicMessageStreamFail overflow policy when a
+// message is received while its channel is full.
+type ErrTopicMessageStreamOverflow struct {
+	TopicID    TopicID
+	BufferSize int
+}
+
+func (e ErrTopicMessageStreamOverflow) Error() string {
+	return fmt.Sprintf("stream of topic %s messages overflowed its buffer of %d results", e.TopicID.String(), e.BufferSize)
+}
+
+// IncompleteTopicMessageReason is why a chunked topic message was given up on before every chunk was received
+type IncompleteTopicMessageReason int
+
+const (
+	// IncompleteTopicMessageTimeout means the missing chunks were not received within the chunk timeout, in consensus
+	// time, of the first chunk
+	IncompleteTopicMessageTimeout IncompleteTopicMessageReason = iota
+	// IncompleteTopicMessageEvicted means the message was the oldest when the pending messages exceeded their budget
+	IncompleteTopicMessageEvicted
+	// IncompleteTopicMessageInconsistent means chunks of the message disagree on the number of chunks, or a chunk has
+	// a number outside of it
+	IncompleteTopicMessageInconsistent
+)
+
+// String returns a description of the reason
+func (reason IncompleteTopicMessageReason) String() string {
+	switch reason {
+	case IncompleteTopicMessageTimeout:
+		return "timed out"
+	case IncompleteTopicMessageEvicted:
+		return "evicted"
+	case IncompleteTopicMessageInconsistent:
+		return "inconsistent chunks"
+	default:
+		return fmt.Sprintf("IncompleteTopicMessageReason(%d)", int(reason))
+	}
+}
+
+// ErrIncompleteTopicMessage is reported when a subscription gives up on a chunked topic message of which some chunks
+// were not received. Chunks holds the received chunks.
+type ErrIncompleteTopicMessage struct {
+	Reason        IncompleteTopicMessageReason
+	TopicID       TopicID
+	TransactionID TransactionID
+	TotalChunks   uint64
+	Chunks        []TopicMessageChunk
+}
+
+func (e ErrIncompleteTopicMessage) Error() string {
+	return fmt.Sprintf("topic %s message %s is incomplete with %d of %d chunks: %s",
+		e.TopicID.String(), e.TransactionID.String(), len(e.Chunks), e.TotalChunks, e.Reason)
+}
@@ -0,0 +1,91 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// EthereumAccessListEntry is an address together with the storage keys of it a transaction plans to access, as
+// defined in EIP-2930.
+type EthereumAccessListEntry struct {
+	Address     []byte
+	StorageKeys [][]byte
+}
+
+// EthereumAccessList is the access list of an EIP-2930 or EIP-1559 Ethereum transaction.
+type EthereumAccessList []EthereumAccessListEntry
+
+// _EthereumAccessListFromRLP decodes an access list, which is a list of [address, [storageKey, ...]] lists
+func _EthereumAccessListFromRLP(item *RLPItem) (EthereumAccessList, error) {
+	if item.itemType != LIST_TYPE {
+		return nil, errors.New("access list should be a list of RLP-encoded elements")
+	}
+
+	accessList := make(EthereumAccessList, 0, len(item.childItems))
+	for _, entry := range item.childItems {
+		if entry.itemType != LIST_TYPE || len(entry.childItems) != 2 {
+			return nil, errors.New("access list entry should be a list of an address and its storage keys")
+		}
+
+		address, storageKeys := entry.childItems[0], entry.childItems[1]
+		if address.itemType != VALUE_TYPE || len(address.itemValue) != 20 {
+			return nil, errors.New("access list address should be 20 bytes")
+		}
+
+		if storageKeys.itemType != LIST_TYPE {
+			return nil, errors.New("access list storage keys should be a list of RLP-encoded elements")
+		}
+
+		keys := make([][]byte, 0, len(storageKeys.childItems))
+		for _, key := range storageKeys.childItems {
+			if key.itemType != VALUE_TYPE || len(key.itemValue) != 32 {
+				return nil, errors.New("access list storage key should be 32 bytes")
+			}
+			keys = append(keys, key.itemValue)
+		}
+
+		accessList = append(accessList, EthereumAccessListEntry{
+			Address:     address.itemValue,
+			StorageKeys: keys,
+		})
+	}
+
+	return accessList, nil
+}
+
+func (accessList EthereumAccessList) _ToRLP() *RLPItem {
+	item := NewRLPItem(LIST_TYPE)
+	for _, entry := range accessList {
+		storageKeys := NewRLPItem(LIST_TYPE)
+		for _, key := range entry.StorageKeys {
+			storageKeys.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(key))
+		}
+
+		entryItem := NewRLPItem(LIST_TYPE)
+		entryItem.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(entry.Address))
+		entryItem.PushBack(storageKeys)
+		item.PushBack(entryItem)
+	}
+
+	return item
+}
+
+// String returns a string representation of the EthereumAccessList.
+func (accessList EthereumAccessList) String() string {
+	entries := make([]string, 0, len(accessList))
+	for _, entry := range accessList {
+		keys := make([]string, 0, len(entry.StorageKeys))
+		for _, key := range entry.StorageKeys {
+			keys = append(keys, hex.EncodeToString(key))
+		}
+
+		entries = append(entries, fmt.Sprintf("{%s: [%s]}", hex.EncodeToString(entry.Address), strings.Join(keys, ", ")))
+	}
+
+	return "[" + strings.Join(entries, ", ") + "]"
+}
@@ -22,3 +22,85 @@ func TestUnitEthereumData(t *testing.T) {
 	k, err := b.ToBytes()
 	require.Equal(t, hex.EncodeToString(k), "02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
 }
+
+func TestUnitEthereumDataEIP2930(t *testing.T) {
+	t.Parallel()
+
+	to, err := hex.DecodeString("7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181")
+	require.NoError(t, err)
+	storageKey, err := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000003")
+	require.NoError(t, err)
+
+	accessList := EthereumAccessList{
+		{Address: to, StorageKeys: [][]byte{storageKey, make([]byte, 32)}},
+		{Address: make([]byte, 20), StorageKeys: [][]byte{}},
+	}
+
+	txn := NewEthereumEIP2930Transaction(
+		[]byte{0x01, 0x2a}, []byte{0x02}, []byte{0x2f}, []byte{0x01, 0x80, 0x00}, to,
+		[]byte{0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00}, []byte{0x12, 0x34, 0x56},
+		[]byte{0x01}, make([]byte, 32), make([]byte, 32), accessList)
+
+	byt, err := txn.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, byte(0x01), byt[0])
+
+	data, err := EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+	require.NotNil(t, data.eip2930)
+	require.Equal(t, []byte{0x12, 0x34, 0x56}, data._GetData())
+	require.Equal(t, accessList, data.eip2930.AccessList)
+	require.Equal(t, txn.String(), data.eip2930.String())
+
+	k, err := data.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, byt, k)
+
+	// The same access list round trips through an EIP-1559 transaction
+	eip1559 := NewEthereumEIP1559Transaction(
+		[]byte{0x01, 0x2a}, []byte{0x02}, []byte{0x2f}, []byte{0x2f}, []byte{0x01, 0x80, 0x00}, to,
+		[]byte{}, []byte{}, []byte{0x01}, make([]byte, 32), make([]byte, 32), accessList)
+	byt, err = eip1559.ToBytes()
+	require.NoError(t, err)
+
+	data, err = EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+	require.NotNil(t, data.eip1559)
+	require.Equal(t, accessList, data.eip1559.AccessList)
+	require.Contains(t, data.eip1559.String(), "AccessList: [{7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181: [")
+}
+
+func TestUnitEthereumDataMalformed(t *testing.T) {
+	t.Parallel()
+
+	_, err := EthereumTransactionDataFromBytes([]byte{})
+	require.Error(t, err)
+
+	// An access list entry whose address isn't 20 bytes long
+	item := NewRLPItem(LIST_TYPE)
+	for i := 0; i < 7; i++ {
+		item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue([]byte{0x01}))
+	}
+	entry := NewRLPItem(LIST_TYPE)
+	entry.PushBack(NewRLPItem(VALUE_TYPE).AssignValue([]byte{0x01, 0x02}))
+	entry.PushBack(NewRLPItem(LIST_TYPE))
+	accessList := NewRLPItem(LIST_TYPE)
+	accessList.PushBack(entry)
+	item.PushBack(accessList)
+	for i := 0; i < 3; i++ {
+		item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue([]byte{0x01}))
+	}
+	byt, err := item.Write()
+	require.NoError(t, err)
+
+	_, err = EthereumTransactionDataFromBytes(append([]byte{0x01}, byt...))
+	require.ErrorContains(t, err, "access list address should be 20 bytes")
+
+	// Truncated transactions of every type
+	for _, prefix := range []byte{0x01, 0x02} {
+		_, err = EthereumTransactionDataFromBytes(append([]byte{prefix}, byt[:len(byt)-1]...))
+		require.Error(t, err)
+	}
+	_, err = EthereumTransactionDataFromBytes(byt[:len(byt)-1])
+	require.Error(t, err)
+}
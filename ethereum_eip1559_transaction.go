@@ -5,7 +5,7 @@ package hiero
 import (
 	"encoding/hex"
 	"fmt"
-	"strings"
+	"math/big"
 
 	"github.com/pkg/errors"
 )
@@ -20,7 +20,7 @@ type EthereumEIP1559Transaction struct {
 	To             []byte
 	Value          []byte
 	CallData       []byte
-	AccessList     [][]byte
+	AccessList     EthereumAccessList
 	RecoveryId     []byte
 	R              []byte
 	S              []byte
@@ -29,7 +29,7 @@ type EthereumEIP1559Transaction struct {
 // nolint
 // NewEthereumEIP1559Transaction creates a new EthereumEIP1559Transaction with the provided fields.
 func NewEthereumEIP1559Transaction(
-	chainId, nonce, maxPriorityGas, maxGas, gasLimit, to, value, callData, recoveryId, r, s []byte, accessList [][]byte) *EthereumEIP1559Transaction {
+	chainId, nonce, maxPriorityGas, maxGas, gasLimit, to, value, callData, recoveryId, r, s []byte, accessList EthereumAccessList) *EthereumEIP1559Transaction {
 	return &EthereumEIP1559Transaction{
 		ChainId:        chainId,
 		Nonce:          nonce,
@@ -62,10 +62,9 @@ func EthereumEIP1559TransactionFromBytes(bytes []byte) (*EthereumEIP1559Transact
 		return nil, errors.New("input byte array is malformed; it should be a list of 12 RLP-encoded elements")
 	}
 
-	// Handle the access list
-	var accessListValues [][]byte
-	for _, child := range item.childItems[8].childItems {
-		accessListValues = append(accessListValues, child.itemValue)
+	accessList, err := _EthereumAccessListFromRLP(item.childItems[8])
+	if err != nil {
+		return nil, err
 	}
 
 	// Extract values from the RLP item
@@ -81,26 +80,13 @@ func EthereumEIP1559TransactionFromBytes(bytes []byte) (*EthereumEIP1559Transact
 		item.childItems[9].itemValue,
 		item.childItems[10].itemValue,
 		item.childItems[11].itemValue,
-		accessListValues,
+		accessList,
 	), nil
 }
 
 // ToBytes encodes the EthereumEIP1559Transaction into RLP format.
 func (txn *EthereumEIP1559Transaction) ToBytes() ([]byte, error) {
-	item := NewRLPItem(LIST_TYPE)
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.ChainId))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Nonce))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.MaxPriorityGas))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.MaxGas))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasLimit))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
-	accessListItem := NewRLPItem(LIST_TYPE)
-	for _, itemBytes := range txn.AccessList {
-		accessListItem.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(itemBytes))
-	}
-	item.PushBack(accessListItem)
+	item := txn._UnsignedRLP()
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.RecoveryId))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.R))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.S))
@@ -115,16 +101,68 @@ func (txn *EthereumEIP1559Transaction) ToBytes() ([]byte, error) {
 	return combinedBytes, nil
 }
 
-// String returns a string representation of the EthereumEIP1559Transaction.
-func (txn *EthereumEIP1559Transaction) String() string {
-	// Encode each element in the AccessList slice individually
-	var encodedAccessList []string
-	for _, entry := range txn.AccessList {
-		encodedAccessList = append(encodedAccessList, hex.EncodeToString(entry))
+// Sign signs the transaction for its chain ID with an ECDSA secp256k1 private key, setting its y-parity recovery id,
+// R and S.
+func (txn *EthereumEIP1559Transaction) Sign(privateKey PrivateKey) error {
+	hash, err := _EthereumSigningHash([]byte{0x02}, txn._UnsignedRLP())
+	if err != nil {
+		return err
+	}
+
+	recoveryId, r, s, err := _EthereumSign(privateKey, hash)
+	if err != nil {
+		return err
+	}
+
+	txn.RecoveryId = _EthereumUint(big.NewInt(int64(recoveryId)))
+	txn.R = r
+	txn.S = s
+	return nil
+}
+
+// RecoverPublicKey returns the public key which signed the transaction.
+func (txn *EthereumEIP1559Transaction) RecoverPublicKey() (PublicKey, error) {
+	recoveryId, err := _EthereumYParity(txn.RecoveryId)
+	if err != nil {
+		return PublicKey{}, err
 	}
 
-	accessListStr := "[" + strings.Join(encodedAccessList, ", ") + "]"
+	hash, err := _EthereumSigningHash([]byte{0x02}, txn._UnsignedRLP())
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	return _EthereumRecoverPublicKey(hash, recoveryId, txn.R, txn.S)
+}
+
+// SenderAddress returns the hex EVM address of the account which signed the transaction.
+func (txn *EthereumEIP1559Transaction) SenderAddress() (string, error) {
+	publicKey, err := txn.RecoverPublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return publicKey.ToEvmAddress(), nil
+}
 
+// _UnsignedRLP is the list of the fields of the transaction which are signed
+func (txn *EthereumEIP1559Transaction) _UnsignedRLP() *RLPItem {
+	item := NewRLPItem(LIST_TYPE)
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.ChainId))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Nonce))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.MaxPriorityGas))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.MaxGas))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasLimit))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
+	item.PushBack(txn.AccessList._ToRLP())
+
+	return item
+}
+
+// String returns a string representation of the EthereumEIP1559Transaction.
+func (txn *EthereumEIP1559Transaction) String() string {
 	return fmt.Sprintf("ChainId: %s\nNonce: %s\nMaxPriorityGas: %s\nMaxGas: %s\nGasLimit: %s\nTo: %s\nValue: %s\nCallData: %s\nAccessList: %s\nRecoveryId: %s\nR: %s\nS: %s",
 		hex.EncodeToString(txn.ChainId),
 		hex.EncodeToString(txn.Nonce),
@@ -134,7 +172,7 @@ func (txn *EthereumEIP1559Transaction) String() string {
 		hex.EncodeToString(txn.To),
 		hex.EncodeToString(txn.Value),
 		hex.EncodeToString(txn.CallData),
-		accessListStr,
+		txn.AccessList.String(),
 		hex.EncodeToString(txn.RecoveryId),
 		hex.EncodeToString(txn.R),
 		hex.EncodeToString(txn.S),
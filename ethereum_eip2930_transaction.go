@@ -0,0 +1,175 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// EthereumEIP2930Transaction represents the EIP-2930 (access list) Ethereum transaction data.
+type EthereumEIP2930Transaction struct {
+	ChainId    []byte
+	Nonce      []byte
+	GasPrice   []byte
+	GasLimit   []byte
+	To         []byte
+	Value      []byte
+	CallData   []byte
+	AccessList EthereumAccessList
+	RecoveryId []byte
+	R          []byte
+	S          []byte
+}
+
+// nolint
+// NewEthereumEIP2930Transaction creates a new EthereumEIP2930Transaction with the provided fields.
+func NewEthereumEIP2930Transaction(
+	chainId, nonce, gasPrice, gasLimit, to, value, callData, recoveryId, r, s []byte, accessList EthereumAccessList) *EthereumEIP2930Transaction {
+	return &EthereumEIP2930Transaction{
+		ChainId:    chainId,
+		Nonce:      nonce,
+		GasPrice:   gasPrice,
+		GasLimit:   gasLimit,
+		To:         to,
+		Value:      value,
+		CallData:   callData,
+		AccessList: accessList,
+		RecoveryId: recoveryId,
+		R:          r,
+		S:          s,
+	}
+}
+
+// FromBytes decodes the RLP encoded bytes into an EthereumEIP2930Transaction.
+func EthereumEIP2930TransactionFromBytes(bytes []byte) (*EthereumEIP2930Transaction, error) {
+	if len(bytes) == 0 || bytes[0] != 0x01 {
+		return nil, errors.New("input byte array is malformed; it should start with 0x01 followed by 11 RLP-encoded elements")
+	}
+
+	// Remove the prefix byte (0x01)
+	item := NewRLPItem(LIST_TYPE)
+	if err := item.Read(bytes[1:]); err != nil {
+		return nil, errors.Wrap(err, "failed to read RLP data")
+	}
+
+	if item.itemType != LIST_TYPE || len(item.childItems) != 11 {
+		return nil, errors.New("input byte array is malformed; it should be a list of 11 RLP-encoded elements")
+	}
+
+	accessList, err := _EthereumAccessListFromRLP(item.childItems[7])
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract values from the RLP item
+	return NewEthereumEIP2930Transaction(
+		item.childItems[0].itemValue,
+		item.childItems[1].itemValue,
+		item.childItems[2].itemValue,
+		item.childItems[3].itemValue,
+		item.childItems[4].itemValue,
+		item.childItems[5].itemValue,
+		item.childItems[6].itemValue,
+		item.childItems[8].itemValue,
+		item.childItems[9].itemValue,
+		item.childItems[10].itemValue,
+		accessList,
+	), nil
+}
+
+// ToBytes encodes the EthereumEIP2930Transaction into RLP format.
+func (txn *EthereumEIP2930Transaction) ToBytes() ([]byte, error) {
+	item := txn._UnsignedRLP()
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.RecoveryId))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.R))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.S))
+
+	transactionBytes, err := item.Write()
+	if err != nil {
+		return nil, err
+	}
+	// Append 01 byte as it is the standard for EIP2930
+	combinedBytes := append([]byte{0x01}, transactionBytes...)
+
+	return combinedBytes, nil
+}
+
+// Sign signs the transaction for its chain ID with an ECDSA secp256k1 private key, setting its y-parity recovery id,
+// R and S.
+func (txn *EthereumEIP2930Transaction) Sign(privateKey PrivateKey) error {
+	hash, err := _EthereumSigningHash([]byte{0x01}, txn._UnsignedRLP())
+	if err != nil {
+		return err
+	}
+
+	recoveryId, r, s, err := _EthereumSign(privateKey, hash)
+	if err != nil {
+		return err
+	}
+
+	txn.RecoveryId = _EthereumUint(big.NewInt(int64(recoveryId)))
+	txn.R = r
+	txn.S = s
+	return nil
+}
+
+// RecoverPublicKey returns the public key which signed the transaction.
+func (txn *EthereumEIP2930Transaction) RecoverPublicKey() (PublicKey, error) {
+	recoveryId, err := _EthereumYParity(txn.RecoveryId)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	hash, err := _EthereumSigningHash([]byte{0x01}, txn._UnsignedRLP())
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	return _EthereumRecoverPublicKey(hash, recoveryId, txn.R, txn.S)
+}
+
+// SenderAddress returns the hex EVM address of the account which signed the transaction.
+func (txn *EthereumEIP2930Transaction) SenderAddress() (string, error) {
+	publicKey, err := txn.RecoverPublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return publicKey.ToEvmAddress(), nil
+}
+
+// _UnsignedRLP is the list of the fields of the transaction which are signed
+func (txn *EthereumEIP2930Transaction) _UnsignedRLP() *RLPItem {
+	item := NewRLPItem(LIST_TYPE)
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.ChainId))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Nonce))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasPrice))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasLimit))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
+	item.PushBack(txn.AccessList._ToRLP())
+
+	return item
+}
+
+// String returns a string representation of the EthereumEIP2930Transaction.
+func (txn *EthereumEIP2930Transaction) String() string {
+	return fmt.Sprintf("ChainId: %s\nNonce: %s\nGasPrice: %s\nGasLimit: %s\nTo: %s\nValue: %s\nCallData: %s\nAccessList: %s\nRecoveryId: %s\nR: %s\nS: %s",
+		hex.EncodeToString(txn.ChainId),
+		hex.EncodeToString(txn.Nonce),
+		hex.EncodeToString(txn.GasPrice),
+		hex.EncodeToString(txn.GasLimit),
+		hex.EncodeToString(txn.To),
+		hex.EncodeToString(txn.Value),
+		hex.EncodeToString(txn.CallData),
+		txn.AccessList.String(),
+		hex.EncodeToString(txn.RecoveryId),
+		hex.EncodeToString(txn.R),
+		hex.EncodeToString(txn.S),
+	)
+}
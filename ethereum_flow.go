@@ -2,7 +2,11 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
-import "github.com/pkg/errors"
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
 
 // Execute an Ethereum transaction on Hiero
 type EthereumFlow struct {
@@ -81,7 +85,7 @@ func (transaction *EthereumFlow) GetNodeAccountIDs() []AccountID {
 	return transaction.nodeAccountIDs
 }
 
-func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (FileID, error) {
+func (transaction *EthereumFlow) _CreateFile(ctx context.Context, callData []byte, client *Client) (FileID, error) {
 	fileCreate := NewFileCreateTransaction()
 	if len(transaction.nodeAccountIDs) > 0 {
 		fileCreate.SetNodeAccountIDs(transaction.nodeAccountIDs)
@@ -90,12 +94,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 	if len(callData) < 4097 {
 		resp, err := fileCreate.
 			SetContents(callData).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
 
-		receipt, err := resp.GetReceipt(client)
+		receipt, err := resp.GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return FileID{}, err
 		}
@@ -105,12 +109,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 	resp, err := fileCreate.
 		SetContents(callData[:4097]).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	receipt, err := resp.GetReceipt(client)
+	receipt, err := resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -120,12 +124,12 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 	resp, err = NewFileAppendTransaction().
 		SetFileID(fileID).
 		SetContents(callData[4097:]).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return FileID{}, err
 	}
@@ -135,6 +139,11 @@ func (transaction *EthereumFlow) _CreateFile(callData []byte, client *Client) (F
 
 // Execute executes the Transaction with the provided client
 func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping early if ctx is done
+func (transaction *EthereumFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if transaction.ethereumData == nil {
 		return TransactionResponse{}, errors.New("cannot submit ethereum transaction with no ethereum data")
 	}
@@ -165,7 +174,7 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 			SetEthereumData(dataBytes)
 	} else {
 		fileID, err := transaction.
-			_CreateFile(dataBytes, client)
+			_CreateFile(ctx, dataBytes, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
@@ -178,12 +187,12 @@ func (transaction *EthereumFlow) Execute(client *Client) (TransactionResponse, e
 	}
 
 	resp, err := ethereumTransaction.
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
 
-	_, err = resp.GetReceipt(client)
+	_, err = resp.GetReceiptWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
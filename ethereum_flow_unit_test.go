@@ -6,6 +6,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"encoding/hex"
 	"testing"
 
@@ -171,7 +172,7 @@ func TestUnitEthereumFlowCoverage(t *testing.T) {
 		SetEthereumDataBytes(byt).
 		SetMaxGasAllowance(NewHbar(2))
 
-	transaction._CreateFile(byt, client)
+	transaction._CreateFile(context.Background(), byt, client)
 
 	require.NoError(t, err)
 	transaction.GetNodeAccountIDs()
@@ -5,6 +5,7 @@ package hiero
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
 )
@@ -69,6 +70,112 @@ func EthereumLegacyTransactionFromBytes(bytes []byte) (*EthereumLegacyTransactio
 
 // ToBytes encodes the EthereumLegacyTransaction into RLP format.
 func (txn *EthereumLegacyTransaction) ToBytes() ([]byte, error) {
+	item := txn._UnsignedRLP()
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.V))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.R))
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.S))
+
+	return item.Write()
+}
+
+// Sign signs the transaction with an ECDSA secp256k1 private key, setting V, R and S. The signature is replay protected
+// as EIP-155 specifies, unless the chain ID is 0.
+func (txn *EthereumLegacyTransaction) Sign(privateKey PrivateKey, chainId uint64) error {
+	chainIdInt := new(big.Int).SetUint64(chainId)
+	hash, err := txn._SigningHash(chainIdInt)
+	if err != nil {
+		return err
+	}
+
+	recoveryId, r, s, err := _EthereumSign(privateKey, hash)
+	if err != nil {
+		return err
+	}
+
+	// V is 27 + the recovery id without replay protection, and chain ID * 2 + 35 + the recovery id with it
+	v := big.NewInt(27 + int64(recoveryId))
+	if chainId != 0 {
+		v.Mul(chainIdInt, big.NewInt(2))
+		v.Add(v, big.NewInt(35+int64(recoveryId)))
+	}
+
+	txn.V = _EthereumUint(v)
+	txn.R = r
+	txn.S = s
+	return nil
+}
+
+// ChainId returns the chain ID the transaction was signed for, which EIP-155 encodes in V, or 0 for a transaction
+// without replay protection.
+func (txn *EthereumLegacyTransaction) ChainId() (uint64, error) {
+	chainId, _, err := txn._Signature()
+	if err != nil {
+		return 0, err
+	}
+
+	if !chainId.IsUint64() {
+		return 0, errors.New("chain ID doesn't fit in 64 bits")
+	}
+
+	return chainId.Uint64(), nil
+}
+
+// RecoverPublicKey returns the public key which signed the transaction.
+func (txn *EthereumLegacyTransaction) RecoverPublicKey() (PublicKey, error) {
+	chainId, recoveryId, err := txn._Signature()
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	hash, err := txn._SigningHash(chainId)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	return _EthereumRecoverPublicKey(hash, recoveryId, txn.R, txn.S)
+}
+
+// SenderAddress returns the hex EVM address of the account which signed the transaction.
+func (txn *EthereumLegacyTransaction) SenderAddress() (string, error) {
+	publicKey, err := txn.RecoverPublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return publicKey.ToEvmAddress(), nil
+}
+
+// _Signature decodes V into the chain ID and the recovery id
+func (txn *EthereumLegacyTransaction) _Signature() (*big.Int, byte, error) {
+	v := new(big.Int).SetBytes(txn.V)
+	if v.Cmp(big.NewInt(27)) == 0 || v.Cmp(big.NewInt(28)) == 0 {
+		return new(big.Int), byte(v.Uint64() - 27), nil
+	}
+
+	if v.Cmp(big.NewInt(35)) < 0 {
+		return nil, 0, errors.New("invalid legacy Ethereum transaction V")
+	}
+
+	v.Sub(v, big.NewInt(35))
+	recoveryId := v.Bit(0)
+	return v.Rsh(v, 1), byte(recoveryId), nil
+}
+
+// _SigningHash is the hash of the fields of the transaction, followed by the chain ID and two empty values with
+// EIP-155 replay protection
+func (txn *EthereumLegacyTransaction) _SigningHash(chainId *big.Int) ([]byte, error) {
+	item := txn._UnsignedRLP()
+	if chainId.Sign() != 0 {
+		item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(_EthereumUint(chainId)))
+		item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue([]byte{}))
+		item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue([]byte{}))
+	}
+
+	return _EthereumSigningHash(nil, item)
+}
+
+// _UnsignedRLP is the list of the fields of the transaction which are signed
+func (txn *EthereumLegacyTransaction) _UnsignedRLP() *RLPItem {
 	item := NewRLPItem(LIST_TYPE)
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Nonce))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.GasPrice))
@@ -76,11 +183,8 @@ func (txn *EthereumLegacyTransaction) ToBytes() ([]byte, error) {
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.To))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.Value))
 	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.CallData))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.V))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.R))
-	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(txn.S))
 
-	return item.Write()
+	return item
 }
 
 // String returns a string representation of the EthereumLegacyTransaction.
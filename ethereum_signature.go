@@ -0,0 +1,106 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	ecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/pkg/errors"
+)
+
+var errEthereumSigningKey = errors.New("only ECDSA secp256k1 keys can sign Ethereum transactions")
+
+// secp256k1HalfOrder is half the order of the secp256k1 curve; Ethereum only accepts signatures with an s up to it
+var secp256k1HalfOrder = new(big.Int).Rsh(secp256k1.S256().N, 1)
+
+// _EthereumSigningHash is the Keccak-256 hash of the RLP encoded item, preceded by the type byte of typed transactions
+func _EthereumSigningHash(transactionType []byte, item *RLPItem) ([]byte, error) {
+	encoded, err := item.Write()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := Keccak256Hash(append(append([]byte{}, transactionType...), encoded...))
+	return hash.Bytes(), nil
+}
+
+// _EthereumSign signs the hash with the key, returning the recovery id and the minimal big endian r and s
+func _EthereumSign(privateKey PrivateKey, hash []byte) (byte, []byte, []byte, error) {
+	if privateKey.ecdsaPrivateKey == nil {
+		return 0, nil, nil, errEthereumSigningKey
+	}
+
+	// The compact signature is a header byte of 27 + 4 + the recovery id, for a compressed public key, followed by
+	// r and s. The signature is deterministic (RFC 6979) and its s is canonical.
+	signature := ecdsa.SignCompact(privateKey.ecdsaPrivateKey.keyData, hash, true)
+
+	return signature[0] - 31, _EthereumUint(new(big.Int).SetBytes(signature[1:33])), _EthereumUint(new(big.Int).SetBytes(signature[33:65])), nil
+}
+
+// _EthereumRecoverPublicKey recovers the public key which signed the hash
+func _EthereumRecoverPublicKey(hash []byte, recoveryId byte, r []byte, s []byte) (PublicKey, error) {
+	if recoveryId > 1 {
+		return PublicKey{}, errors.New("invalid Ethereum signature recovery id")
+	}
+
+	rInt, sInt := new(big.Int).SetBytes(r), new(big.Int).SetBytes(s)
+	if rInt.Sign() == 0 || sInt.Sign() == 0 || rInt.Cmp(secp256k1.S256().N) >= 0 || sInt.Cmp(secp256k1HalfOrder) > 0 {
+		return PublicKey{}, errors.New("invalid Ethereum signature values")
+	}
+
+	signature := make([]byte, 65)
+	signature[0] = 27 + 4 + recoveryId
+	rInt.FillBytes(signature[1:33])
+	sInt.FillBytes(signature[33:65])
+
+	publicKey, _, err := ecdsa.RecoverCompact(signature, hash)
+	if err != nil {
+		return PublicKey{}, errors.Wrap(err, "failed to recover Ethereum signer")
+	}
+
+	return PublicKey{
+		ecdsaPublicKey: &_ECDSAPublicKey{publicKey},
+	}, nil
+}
+
+// _EthereumYParity decodes the recovery id of a typed transaction, which is 0 or 1
+func _EthereumYParity(recoveryId []byte) (byte, error) {
+	yParity := new(big.Int).SetBytes(recoveryId)
+	if !yParity.IsUint64() || yParity.Uint64() > 1 {
+		return 0, errors.New("invalid Ethereum signature recovery id")
+	}
+
+	return byte(yParity.Uint64()), nil
+}
+
+// _EthereumUint encodes an integer the way RLP does: big endian without leading zeros, and empty for zero
+func _EthereumUint(value *big.Int) []byte {
+	if value == nil {
+		return []byte{}
+	}
+
+	return value.Bytes()
+}
+
+// _EthereumAddress parses a hex EVM address, with or without the 0x prefix. An empty address is the one of a contract
+// creation.
+func _EthereumAddress(address string) ([]byte, error) {
+	if address == "" {
+		return []byte{}, nil
+	}
+
+	bytes, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid EVM address")
+	}
+
+	if len(bytes) != 20 {
+		return nil, errors.New("EVM address should be 20 bytes")
+	}
+
+	return bytes, nil
+}
@@ -0,0 +1,220 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import "math/big"
+
+// EthereumTransactionBuilder builds and signs Ethereum transactions with an ECDSA secp256k1 private key, for use with
+// EthereumFlow or EthereumTransaction.
+type EthereumTransactionBuilder struct {
+	chainId        uint64
+	nonce          uint64
+	gasPrice       *big.Int
+	maxPriorityGas *big.Int
+	maxGas         *big.Int
+	gasLimit       uint64
+	to             string
+	value          *big.Int
+	callData       []byte
+	accessList     EthereumAccessList
+}
+
+// NewEthereumTransactionBuilder creates an EthereumTransactionBuilder for a contract creation with no value, gas or
+// call data.
+func NewEthereumTransactionBuilder() *EthereumTransactionBuilder {
+	return &EthereumTransactionBuilder{
+		gasPrice:       new(big.Int),
+		maxPriorityGas: new(big.Int),
+		maxGas:         new(big.Int),
+		value:          new(big.Int),
+		callData:       []byte{},
+		accessList:     EthereumAccessList{},
+	}
+}
+
+// SetChainId sets the chain ID the transaction is signed for.
+func (builder *EthereumTransactionBuilder) SetChainId(chainId uint64) *EthereumTransactionBuilder {
+	builder.chainId = chainId
+	return builder
+}
+
+// GetChainId returns the chain ID the transaction is signed for.
+func (builder *EthereumTransactionBuilder) GetChainId() uint64 {
+	return builder.chainId
+}
+
+// SetNonce sets the nonce of the sender account.
+func (builder *EthereumTransactionBuilder) SetNonce(nonce uint64) *EthereumTransactionBuilder {
+	builder.nonce = nonce
+	return builder
+}
+
+// GetNonce returns the nonce of the sender account.
+func (builder *EthereumTransactionBuilder) GetNonce() uint64 {
+	return builder.nonce
+}
+
+// SetGasPrice sets the gas price in weibars of legacy and EIP-2930 transactions.
+func (builder *EthereumTransactionBuilder) SetGasPrice(gasPrice *big.Int) *EthereumTransactionBuilder {
+	builder.gasPrice = gasPrice
+	return builder
+}
+
+// GetGasPrice returns the gas price in weibars of legacy and EIP-2930 transactions.
+func (builder *EthereumTransactionBuilder) GetGasPrice() *big.Int {
+	return builder.gasPrice
+}
+
+// SetMaxPriorityGas sets the maximum priority fee per gas in weibars of EIP-1559 transactions.
+func (builder *EthereumTransactionBuilder) SetMaxPriorityGas(maxPriorityGas *big.Int) *EthereumTransactionBuilder {
+	builder.maxPriorityGas = maxPriorityGas
+	return builder
+}
+
+// GetMaxPriorityGas returns the maximum priority fee per gas in weibars of EIP-1559 transactions.
+func (builder *EthereumTransactionBuilder) GetMaxPriorityGas() *big.Int {
+	return builder.maxPriorityGas
+}
+
+// SetMaxGas sets the maximum fee per gas in weibars of EIP-1559 transactions.
+func (builder *EthereumTransactionBuilder) SetMaxGas(maxGas *big.Int) *EthereumTransactionBuilder {
+	builder.maxGas = maxGas
+	return builder
+}
+
+// GetMaxGas returns the maximum fee per gas in weibars of EIP-1559 transactions.
+func (builder *EthereumTransactionBuilder) GetMaxGas() *big.Int {
+	return builder.maxGas
+}
+
+// SetGasLimit sets the maximum amount of gas the transaction can use.
+func (builder *EthereumTransactionBuilder) SetGasLimit(gasLimit uint64) *EthereumTransactionBuilder {
+	builder.gasLimit = gasLimit
+	return builder
+}
+
+// GetGasLimit returns the maximum amount of gas the transaction can use.
+func (builder *EthereumTransactionBuilder) GetGasLimit() uint64 {
+	return builder.gasLimit
+}
+
+// SetTo sets the hex EVM address of the receiver, with or without the 0x prefix. An empty address creates a contract.
+func (builder *EthereumTransactionBuilder) SetTo(to string) *EthereumTransactionBuilder {
+	builder.to = to
+	return builder
+}
+
+// GetTo returns the hex EVM address of the receiver.
+func (builder *EthereumTransactionBuilder) GetTo() string {
+	return builder.to
+}
+
+// SetValue sets the value in weibars transferred to the receiver.
+func (builder *EthereumTransactionBuilder) SetValue(value *big.Int) *EthereumTransactionBuilder {
+	builder.value = value
+	return builder
+}
+
+// GetValue returns the value in weibars transferred to the receiver.
+func (builder *EthereumTransactionBuilder) GetValue() *big.Int {
+	return builder.value
+}
+
+// SetCallData sets the call data, or the init code of a contract creation.
+func (builder *EthereumTransactionBuilder) SetCallData(callData []byte) *EthereumTransactionBuilder {
+	builder.callData = callData
+	return builder
+}
+
+// GetCallData returns the call data.
+func (builder *EthereumTransactionBuilder) GetCallData() []byte {
+	return builder.callData
+}
+
+// SetAccessList sets the access list of EIP-2930 and EIP-1559 transactions.
+func (builder *EthereumTransactionBuilder) SetAccessList(accessList EthereumAccessList) *EthereumTransactionBuilder {
+	builder.accessList = accessList
+	return builder
+}
+
+// GetAccessList returns the access list of EIP-2930 and EIP-1559 transactions.
+func (builder *EthereumTransactionBuilder) GetAccessList() EthereumAccessList {
+	return builder.accessList
+}
+
+// SignLegacy builds a legacy transaction signed with the EIP-155 replay protection of the chain ID.
+func (builder *EthereumTransactionBuilder) SignLegacy(privateKey PrivateKey) (*EthereumTransactionData, error) {
+	to, err := _EthereumAddress(builder.to)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := NewEthereumLegacyTransaction(
+		_EthereumUint(new(big.Int).SetUint64(builder.nonce)),
+		_EthereumUint(builder.gasPrice),
+		_EthereumUint(new(big.Int).SetUint64(builder.gasLimit)),
+		to,
+		_EthereumUint(builder.value),
+		builder.callData,
+		nil, nil, nil,
+	)
+
+	if err := txn.Sign(privateKey, builder.chainId); err != nil {
+		return nil, err
+	}
+
+	return &EthereumTransactionData{legacy: txn}, nil
+}
+
+// SignEIP2930 builds an EIP-2930 transaction with an access list and signs it.
+func (builder *EthereumTransactionBuilder) SignEIP2930(privateKey PrivateKey) (*EthereumTransactionData, error) {
+	to, err := _EthereumAddress(builder.to)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := NewEthereumEIP2930Transaction(
+		_EthereumUint(new(big.Int).SetUint64(builder.chainId)),
+		_EthereumUint(new(big.Int).SetUint64(builder.nonce)),
+		_EthereumUint(builder.gasPrice),
+		_EthereumUint(new(big.Int).SetUint64(builder.gasLimit)),
+		to,
+		_EthereumUint(builder.value),
+		builder.callData,
+		nil, nil, nil,
+		builder.accessList,
+	)
+
+	if err := txn.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return &EthereumTransactionData{eip2930: txn}, nil
+}
+
+// SignEIP1559 builds an EIP-1559 transaction with priority fees and signs it.
+func (builder *EthereumTransactionBuilder) SignEIP1559(privateKey PrivateKey) (*EthereumTransactionData, error) {
+	to, err := _EthereumAddress(builder.to)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := NewEthereumEIP1559Transaction(
+		_EthereumUint(new(big.Int).SetUint64(builder.chainId)),
+		_EthereumUint(new(big.Int).SetUint64(builder.nonce)),
+		_EthereumUint(builder.maxPriorityGas),
+		_EthereumUint(builder.maxGas),
+		_EthereumUint(new(big.Int).SetUint64(builder.gasLimit)),
+		to,
+		_EthereumUint(builder.value),
+		builder.callData,
+		nil, nil, nil,
+		builder.accessList,
+	)
+
+	if err := txn.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return &EthereumTransactionData{eip1559: txn}, nil
+}
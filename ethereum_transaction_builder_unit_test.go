@@ -0,0 +1,146 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitEthereumTransactionBuilderEIP155Vector(t *testing.T) {
+	t.Parallel()
+
+	// The example of EIP-155
+	privateKey, err := PrivateKeyFromStringECDSA("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+
+	data, err := NewEthereumTransactionBuilder().
+		SetChainId(1).
+		SetNonce(9).
+		SetGasPrice(big.NewInt(20000000000)).
+		SetGasLimit(21000).
+		SetTo("0x3535353535353535353535353535353535353535").
+		SetValue(big.NewInt(1000000000000000000)).
+		SignLegacy(privateKey)
+	require.NoError(t, err)
+
+	byt, err := data.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", hex.EncodeToString(byt))
+
+	parsed, err := EthereumTransactionDataFromBytes(byt)
+	require.NoError(t, err)
+	sender, err := parsed.SenderAddress()
+	require.NoError(t, err)
+	assert.Equal(t, "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", sender)
+	assert.Equal(t, privateKey.PublicKey().ToEvmAddress(), sender)
+
+	chainId, err := parsed.legacy.ChainId()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), chainId)
+
+	// Without replay protection
+	require.NoError(t, parsed.legacy.Sign(privateKey, 0))
+	chainId, err = parsed.legacy.ChainId()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), chainId)
+	sender, err = parsed.legacy.SenderAddress()
+	require.NoError(t, err)
+	assert.Equal(t, "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", sender)
+}
+
+func TestUnitEthereumTransactionBuilderTyped(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	storageKey := make([]byte, 32)
+	storageKey[31] = 1
+	builder := NewEthereumTransactionBuilder().
+		SetChainId(298).
+		SetNonce(2).
+		SetGasPrice(big.NewInt(710000000000)).
+		SetMaxPriorityGas(big.NewInt(0)).
+		SetMaxGas(big.NewInt(710000000000)).
+		SetGasLimit(1000000).
+		SetTo("7e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181").
+		SetCallData([]byte{0x12, 0x34, 0x56}).
+		SetAccessList(EthereumAccessList{{Address: make([]byte, 20), StorageKeys: [][]byte{storageKey}}})
+
+	for _, sign := range []func(PrivateKey) (*EthereumTransactionData, error){builder.SignEIP2930, builder.SignEIP1559} {
+		data, err := sign(privateKey)
+		require.NoError(t, err)
+
+		byt, err := data.ToBytes()
+		require.NoError(t, err)
+
+		parsed, err := EthereumTransactionDataFromBytes(byt)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x12, 0x34, 0x56}, parsed._GetData())
+
+		publicKey, err := parsed.RecoverPublicKey()
+		require.NoError(t, err)
+		assert.Equal(t, privateKey.PublicKey().StringRaw(), publicKey.StringRaw())
+
+		// Changing a signed field recovers another sender
+		parsed._SetData([]byte{0x12, 0x34, 0x57})
+		sender, err := parsed.SenderAddress()
+		require.NoError(t, err)
+		assert.NotEqual(t, privateKey.PublicKey().ToEvmAddress(), sender)
+	}
+
+	data, err := builder.SignEIP1559(privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x2a}, data.eip1559.ChainId)
+	assert.Equal(t, []byte{}, data.eip1559.MaxPriorityGas)
+	assert.LessOrEqual(t, len(data.eip1559.RecoveryId), 1)
+}
+
+func TestUnitEthereumTransactionBuilderInvalid(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = NewEthereumTransactionBuilder().SetChainId(298).SignEIP1559(ed25519Key)
+	require.ErrorContains(t, err, "only ECDSA secp256k1 keys")
+
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	_, err = NewEthereumTransactionBuilder().SetTo("0x1234").SignLegacy(ecdsaKey)
+	require.ErrorContains(t, err, "EVM address should be 20 bytes")
+
+	// A contract creation has an empty receiver
+	data, err := NewEthereumTransactionBuilder().SetChainId(298).SetCallData([]byte{0x60, 0x80}).SignLegacy(ecdsaKey)
+	require.NoError(t, err)
+	assert.Empty(t, data.legacy.To)
+
+	data.legacy.V = []byte{0x01}
+	_, err = data.SenderAddress()
+	require.ErrorContains(t, err, "invalid legacy Ethereum transaction V")
+
+	data, err = NewEthereumTransactionBuilder().SetChainId(298).SignEIP2930(ecdsaKey)
+	require.NoError(t, err)
+	data.eip2930.RecoveryId = []byte{0x02}
+	_, err = data.SenderAddress()
+	require.Error(t, err)
+
+	// A signature with a high s isn't accepted
+	data, err = NewEthereumTransactionBuilder().SetChainId(298).SignEIP2930(ecdsaKey)
+	require.NoError(t, err)
+	s := new(big.Int).SetBytes(data.eip2930.S)
+	data.eip2930.S = new(big.Int).Sub(secp256k1.S256().N, s).Bytes()
+	_, err = data.SenderAddress()
+	require.ErrorContains(t, err, "invalid Ethereum signature values")
+
+	_, err = (&EthereumTransactionData{}).SenderAddress()
+	require.Error(t, err)
+}
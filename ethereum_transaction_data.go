@@ -2,15 +2,32 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "github.com/pkg/errors"
+
 // Represents the data of an Ethereum transaction.
 type EthereumTransactionData struct {
 	eip1559 *EthereumEIP1559Transaction
+	eip2930 *EthereumEIP2930Transaction
 	legacy  *EthereumLegacyTransaction
 }
 
 // EthereumTransactionDataFromBytes constructs an EthereumTransactionData from a raw byte array.
 func EthereumTransactionDataFromBytes(b []byte) (*EthereumTransactionData, error) {
 	var transactionData EthereumTransactionData
+	if len(b) == 0 {
+		return nil, errors.New("input byte array is empty")
+	}
+
+	if b[0] == 0x01 {
+		eip2930, err := EthereumEIP2930TransactionFromBytes(b)
+		if err != nil {
+			return nil, err
+		}
+
+		transactionData.eip2930 = eip2930
+		return &transactionData, nil
+	}
+
 	if b[0] == 0x02 {
 		eip1559, err := EthereumEIP1559TransactionFromBytes(b)
 		if err != nil {
@@ -36,6 +53,10 @@ func (txData *EthereumTransactionData) ToBytes() ([]byte, error) {
 		return txData.eip1559.ToBytes()
 	}
 
+	if txData.eip2930 != nil {
+		return txData.eip2930.ToBytes()
+	}
+
 	if txData.legacy != nil {
 		return txData.legacy.ToBytes()
 	}
@@ -43,11 +64,42 @@ func (txData *EthereumTransactionData) ToBytes() ([]byte, error) {
 	return nil, nil
 }
 
+// RecoverPublicKey returns the public key which signed the Ethereum transaction.
+func (txData *EthereumTransactionData) RecoverPublicKey() (PublicKey, error) {
+	if txData.eip1559 != nil {
+		return txData.eip1559.RecoverPublicKey()
+	}
+
+	if txData.eip2930 != nil {
+		return txData.eip2930.RecoverPublicKey()
+	}
+
+	if txData.legacy != nil {
+		return txData.legacy.RecoverPublicKey()
+	}
+
+	return PublicKey{}, errors.New("ethereum transaction data is empty")
+}
+
+// SenderAddress returns the hex EVM address of the account which signed the Ethereum transaction.
+func (txData *EthereumTransactionData) SenderAddress() (string, error) {
+	publicKey, err := txData.RecoverPublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return publicKey.ToEvmAddress(), nil
+}
+
 func (ethereumTxData *EthereumTransactionData) _GetData() []byte {
 	if ethereumTxData.eip1559 != nil {
 		return ethereumTxData.eip1559.CallData
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		return ethereumTxData.eip2930.CallData
+	}
+
 	return ethereumTxData.legacy.CallData
 }
 
@@ -57,6 +109,11 @@ func (ethereumTxData *EthereumTransactionData) _SetData(data []byte) *EthereumTr
 		return ethereumTxData
 	}
 
+	if ethereumTxData.eip2930 != nil {
+		ethereumTxData.eip2930.CallData = data
+		return ethereumTxData
+	}
+
 	ethereumTxData.legacy.CallData = data
 	return ethereumTxData
 }
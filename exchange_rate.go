@@ -16,6 +16,16 @@ type ExchangeRate struct {
 	expirationTime *services.TimestampSeconds
 }
 
+// NewExchangeRate returns the exchange rate where hbars hbar are worth cents US cents
+func NewExchangeRate(hbars int32, cents int32) ExchangeRate {
+	return ExchangeRate{Hbars: hbars, cents: cents}
+}
+
+// GetCents returns how many US cents Hbars hbar are worth
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
 func _ExchangeRateFromProtobuf(protoExchange *services.ExchangeRate) ExchangeRate {
 	if protoExchange == nil {
 		return ExchangeRate{}
@@ -36,9 +36,10 @@ type Executable interface {
 	GetMaxRetry() int
 	GetNodeAccountIDs() []AccountID
 	GetLogLevel() *LogLevel
+	GetRetryPolicy() RetryPolicy
 
 	shouldRetry(Executable, interface{}) _ExecutionState
-	makeRequest() interface{}
+	makeRequest(ctx context.Context) (interface{}, error)
 	advanceRequest()
 	getNodeAccountID() AccountID
 	getMethod(*_Channel) _Method
@@ -60,6 +61,7 @@ type executable struct {
 	grpcDeadline   *time.Duration
 	maxRetry       int
 	logLevel       *LogLevel
+	retryPolicy    RetryPolicy
 }
 
 type _Method struct {
@@ -160,6 +162,17 @@ func (e *executable) SetLogLevel(level LogLevel) *executable {
 	return e
 }
 
+// GetRetryPolicy returns the retry policy set on this request, or nil if the client's policy is used.
+func (e *executable) GetRetryPolicy() RetryPolicy {
+	return e.retryPolicy
+}
+
+// SetRetryPolicy overrides the client's retry policy for this request.
+func (e *executable) SetRetryPolicy(policy RetryPolicy) *executable {
+	e.retryPolicy = policy
+	return e
+}
+
 func (e *executable) getLogger(clientLogger Logger) Logger {
 	if e.logLevel != nil {
 		return clientLogger.SubLoggerWithLevel(*e.logLevel)
@@ -171,8 +184,68 @@ func (e *executable) getNodeAccountID() AccountID {
 	return e.nodeAccountIDs._GetCurrent().(AccountID)
 }
 
+type _ExecutionStats struct {
+	attempts      int64
+	nodeAccountID AccountID
+	status        Status
+}
+
+// _executionKey is the context key of the _ExecutionStats of the execution in progress
+type _executionKey struct{}
+
+func _Execute(ctx context.Context, client *Client, e Executable) (interface{}, error) {
+	interceptors := client._GetInterceptors()
+	if stats, ok := ctx.Value(_executionKey{}).(*_ExecutionStats); ok {
+		return _ExecuteAttempts(ctx, client, e, interceptors, stats)
+	}
+	if len(interceptors) == 0 {
+		return _ExecuteAttempts(ctx, client, e, interceptors, &_ExecutionStats{})
+	}
+
+	return _ExecuteScoped(ctx, client, e, func(ctx context.Context) (interface{}, error) {
+		return _Execute(ctx, client, e)
+	})
+}
+
+// _ExecuteScoped runs execute as a single execution of e: interceptors are notified once when it starts and once when
+// it ends, and the requests it sends with _Execute, like the cost query of a paid query, are attempts of it.
+func _ExecuteScoped(ctx context.Context, client *Client, e Executable, execute func(context.Context) (interface{}, error)) (interface{}, error) {
+	interceptors := client._GetInterceptors()
+	if _, ok := ctx.Value(_executionKey{}).(*_ExecutionStats); ok || len(interceptors) == 0 {
+		return execute(ctx)
+	}
+
+	txID, _ := e.getTransactionIDAndMessage()
+	event := ExecuteEvent{
+		Context:       ctx,
+		Name:          e.getName(),
+		RequestID:     e.getLogID(e),
+		TransactionID: txID,
+	}
+	event.Context = interceptors._OnExecuteStart(event)
+
+	var stats _ExecutionStats
+	start := time.Now()
+	resp, err := execute(context.WithValue(event.Context, _executionKey{}, &stats))
+
+	endEvent := ExecuteEndEvent{
+		ExecuteEvent:  event,
+		Attempts:      stats.attempts,
+		NodeAccountID: stats.nodeAccountID,
+		Status:        stats.status,
+		Duration:      time.Since(start),
+		Err:           err,
+	}
+	if query, ok := e.(interface{ GetQueryPayment() Hbar }); ok {
+		endEvent.QueryPayment = query.GetQueryPayment()
+	}
+	interceptors._OnExecuteEnd(endEvent)
+
+	return resp, err
+}
+
 // nolint
-func _Execute(client *Client, e Executable) (interface{}, error) {
+func _ExecuteAttempts(ctx context.Context, client *Client, e Executable, interceptors _Interceptors, stats *_ExecutionStats) (interface{}, error) {
 	var maxAttempts int
 
 	if client.maxAttempts != nil {
@@ -181,32 +254,57 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		maxAttempts = e.GetMaxRetry()
 	}
 
-	currentBackoff := e.GetMinBackoff()
+	retryPolicy := e.GetRetryPolicy()
+	if retryPolicy == nil {
+		retryPolicy = client.GetRetryPolicy()
+	}
 
 	var attempt int64
 	var errPersistent error
 	var marshaledRequest []byte
+	var previousDelay time.Duration
+	rotate := true
 
 	txLogger := e.getLogger(client.logger)
 	txID, msg := e.getTransactionIDAndMessage()
 
+	retry := func(info RequestInfo, retryAttempt RetryAttempt, err error) error {
+		rotate = retryPolicy.RotateNode(retryAttempt)
+		if rotate {
+			e.advanceRequest()
+		}
+
+		previousDelay = retryPolicy.Delay(retryAttempt)
+		if previousDelay <= 0 {
+			return nil
+		}
+
+		interceptors._OnBackoff(BackoffEvent{RequestInfo: info, Delay: previousDelay, Err: err})
+		return _DelayForAttempt(ctx, e.getLogID(e), previousDelay, attempt, txLogger, err)
+	}
+
 	for attempt = int64(0); attempt < int64(maxAttempts); attempt++ {
 		var protoRequest interface{}
 		var node *_Node
 		var ok bool
 
-		// If this is not the first attempt, double the backoff time up to the max backoff time
-		if attempt > 0 && currentBackoff <= e.GetMaxBackoff() {
-			currentBackoff *= 2
+		if ctx.Err() != nil {
+			return _ExecutableContextDone(e, ctx.Err())
 		}
 
 		if e.isTransaction() {
-			if attempt > 0 && len(e.GetNodeAccountIDs()) > 1 {
+			if attempt > 0 && len(e.GetNodeAccountIDs()) > 1 && rotate {
 				e.advanceRequest()
 			}
 		}
 
-		protoRequest = e.makeRequest()
+		protoRequest, err := e.makeRequest(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return _ExecutableContextDone(e, ctx.Err())
+			}
+			return _ExecutableRequestError(e, err)
+		}
 		if len(e.GetNodeAccountIDs()) == 0 {
 			node = client.network._GetNode()
 		} else {
@@ -224,11 +322,28 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 
 		node._InUse()
 
+		stats.attempts++
+		stats.nodeAccountID = node.accountID
+
+		requestTxID, _ := e.getTransactionIDAndMessage()
+		requestInfo := RequestInfo{
+			Context:       ctx,
+			Name:          e.getName(),
+			RequestID:     e.getLogID(e),
+			TransactionID: requestTxID,
+			NodeAccountID: node.accountID,
+			Attempt:       attempt,
+		}
+
 		txLogger.Trace("executing", "requestId", e.getLogID(e), "nodeAccountID", node.accountID.String(), "nodeIPAddress", node.address._String(), "Request Proto", hex.EncodeToString(marshaledRequest))
 
 		if !node._IsHealthy() {
 			txLogger.Trace("node is unhealthy, waiting before continuing", "requestId", e.getLogID(e), "delay", node._Wait().String())
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger, errNodeIsUnhealthy)
+			backoff := _ExponentialBackoff(e.GetMinBackoff(), e.GetMaxBackoff(), attempt)
+			interceptors._OnBackoff(BackoffEvent{RequestInfo: requestInfo, Delay: backoff, Err: errNodeIsUnhealthy})
+			if err := _DelayForAttempt(ctx, e.getLogID(e), backoff, attempt, txLogger, errNodeIsUnhealthy); err != nil {
+				return _ExecutableContextDone(e, err)
+			}
 			continue
 		}
 
@@ -236,34 +351,36 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		channel, err := node._GetChannel(txLogger)
 		if err != nil {
 			client.network._IncreaseBackoff(node)
+			_ExecutableNodeUnhealthy(interceptors, requestInfo, node, err)
 			errPersistent = err
 			continue
 		}
 
-		e.advanceRequest()
-
 		method := e.getMethod(channel)
 
 		var resp interface{}
 
-		ctx := context.TODO()
+		grpcCtx := ctx
 		var cancel context.CancelFunc
 
 		if e.GetGrpcDeadline() != nil {
 			grpcDeadline := time.Now().Add(*e.GetGrpcDeadline())
-			ctx, cancel = context.WithDeadline(ctx, grpcDeadline)
+			grpcCtx, cancel = context.WithDeadline(ctx, grpcDeadline)
 		}
 
 		txLogger.Trace("executing gRPC call", "requestId", e.getLogID(e))
 
+		interceptors._OnAttempt(AttemptEvent{RequestInfo: requestInfo, Request: protoRequest.(protobuf.Message)})
+		callStart := time.Now()
+
 		var marshaledResponse []byte
 		if method.query != nil {
-			resp, err = method.query(ctx, protoRequest.(*services.Query))
+			resp, err = method.query(grpcCtx, protoRequest.(*services.Query))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.Response))
 			}
 		} else {
-			resp, err = method.transaction(ctx, protoRequest.(*services.Transaction))
+			resp, err = method.transaction(grpcCtx, protoRequest.(*services.Transaction))
 			if err == nil {
 				marshaledResponse, _ = protobuf.Marshal(resp.(*services.TransactionResponse))
 			}
@@ -272,10 +389,31 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 		if cancel != nil {
 			cancel()
 		}
+		latency := time.Since(callStart)
+
 		if err != nil {
+			interceptors._OnResponse(ResponseEvent{RequestInfo: requestInfo, Err: err, Latency: latency})
+
+			// The caller's context ending is not a node failure, so don't penalize the node for it
+			if ctx.Err() != nil {
+				return _ExecutableContextDone(e, ctx.Err())
+			}
 			errPersistent = err
-			if _ExecutableDefaultRetryHandler(e.getLogID(e), err, txLogger) {
+			retryAttempt := RetryAttempt{
+				Attempt:       attempt,
+				NodeAccountID: node.accountID,
+				GrpcError:     err,
+				Retryable:     _ExecutableDefaultRetryHandler(e.getLogID(e), err, txLogger),
+				MinBackoff:    e.GetMinBackoff(),
+				MaxBackoff:    e.GetMaxBackoff(),
+				PreviousDelay: previousDelay,
+			}
+			if retryPolicy.ShouldRetry(retryAttempt) {
 				client.network._IncreaseBackoff(node)
+				_ExecutableNodeUnhealthy(interceptors, requestInfo, node, errPersistent)
+				if err := retry(requestInfo, retryAttempt, errPersistent); err != nil {
+					return _ExecutableContextDone(e, err)
+				}
 				continue
 			}
 			if errPersistent == nil {
@@ -293,6 +431,14 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 
 		statusError := e.mapStatusError(e, resp)
 
+		stats.status = _ExecutableStatusFromError(statusError)
+		interceptors._OnResponse(ResponseEvent{
+			RequestInfo: requestInfo,
+			Status:      stats.status,
+			Latency:     latency,
+			Response:    resp.(protobuf.Message),
+		})
+
 		txLogger.Trace(
 			msg,
 			"requestID", e.getLogID(e),
@@ -304,11 +450,30 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 			"txID", txID,
 		)
 
-		switch e.shouldRetry(e, resp) {
-		case executionStateRetry:
-			errPersistent = statusError
-			_DelayForAttempt(e.getLogID(e), currentBackoff, attempt, txLogger, errPersistent)
-			continue
+		executionState := e.shouldRetry(e, resp)
+		if executionState == executionStateRetry || executionState == executionStateError {
+			retryAttempt := RetryAttempt{
+				Attempt:       attempt,
+				NodeAccountID: node.accountID,
+				Status:        _ExecutableStatusFromError(statusError),
+				Retryable:     executionState == executionStateRetry,
+				MinBackoff:    e.GetMinBackoff(),
+				MaxBackoff:    e.GetMaxBackoff(),
+				PreviousDelay: previousDelay,
+			}
+			if retryPolicy.ShouldRetry(retryAttempt) {
+				errPersistent = statusError
+				if err := retry(requestInfo, retryAttempt, errPersistent); err != nil {
+					return _ExecutableContextDone(e, err)
+				}
+				continue
+			}
+			executionState = executionStateError
+		}
+
+		e.advanceRequest()
+
+		switch executionState {
 		case executionStateExpired:
 			if e.isTransaction() {
 				transaction := e.(TransactionInterface)
@@ -346,10 +511,59 @@ func _Execute(client *Client, e Executable) (interface{}, error) {
 	return &services.Response{}, errPersistent
 }
 
-func _DelayForAttempt(logID string, backoff time.Duration, attempt int64, logger Logger, err error) {
+// _DelayForAttempt waits for the backoff duration, returning early with the context's error if it ends first.
+func _DelayForAttempt(ctx context.Context, logID string, backoff time.Duration, attempt int64, logger Logger, err error) error {
 	logger.Trace("retrying request attempt", "requestId", logID, "delay", backoff, "attempt", attempt+1, "error", err)
 
-	time.Sleep(backoff)
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func _ExecutableNodeUnhealthy(interceptors _Interceptors, info RequestInfo, node *_Node, err error) {
+	if len(interceptors) == 0 {
+		return
+	}
+
+	event := NodeUnhealthyEvent{RequestInfo: info, Address: node.address._String(), Err: err}
+	if readmitTime := node._GetReadmitTime(); readmitTime != nil {
+		event.ReadmitTime = *readmitTime
+	}
+
+	interceptors._OnNodeUnhealthy(event)
+}
+
+func _ExecutableStatusFromError(err error) Status {
+	switch statusErr := err.(type) {
+	case ErrHederaPreCheckStatus:
+		return statusErr.Status
+	case ErrHederaReceiptStatus:
+		return statusErr.Status
+	default:
+		return StatusOk
+	}
+}
+
+func _ExecutableContextDone(e Executable, err error) (interface{}, error) {
+	if e.isTransaction() {
+		return TransactionResponse{}, ErrContextDone{Err: err}
+	}
+
+	return &services.Response{}, ErrContextDone{Err: err}
+}
+
+func _ExecutableRequestError(e Executable, err error) (interface{}, error) {
+	if e.isTransaction() {
+		return TransactionResponse{}, err
+	}
+
+	return &services.Response{}, err
 }
 
 func _ExecutableDefaultRetryHandler(logID string, err error, logger Logger) bool {
@@ -0,0 +1,201 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitExecuteWithContextCancelledBeforeExecution(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		ExecuteWithContext(ctx, client)
+	require.Error(t, err)
+
+	var ctxErr ErrContextDone
+	require.True(t, errors.As(err, &ctxErr))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnitExecuteWithContextStopsBackoff(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		SetMinBackoff(5*time.Second).
+		SetMaxBackoff(10*time.Second).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestUnitExecuteWithContextCancelsInFlightCall(t *testing.T) {
+	t.Parallel()
+
+	call := func(request *services.Query) *services.Response {
+		time.Sleep(time.Second)
+		return &services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+				},
+			},
+		}
+	}
+	responses := [][]interface{}{{
+		call,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		ExecuteWithContext(ctx, client)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestUnitExecuteWithContextGetReceipt(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+		&services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					Receipt: &services.TransactionReceipt{
+						Status: services.ResponseCodeEnum_SUCCESS,
+					},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	resp, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		ExecuteWithContext(context.Background(), client)
+	require.NoError(t, err)
+
+	receipt, err := resp.GetReceiptWithContext(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, StatusSuccess, receipt.Status)
+}
+
+func TestUnitExecuteWithContextFlowsAndMirrorQueries(t *testing.T) {
+	t.Parallel()
+
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+	client.SetMirrorNetwork([]string{"127.0.0.1:5600"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ethereumData, err := hex.DecodeString("02f87082012a022f2f83018000947e3a9eaf9bcc39e2ffa38eb30bf7a93feacbc181880de0b6b3a764000083123456c001a0df48f2efd10421811de2bfb125ab75b2d3c44139c4642837fb1fccce911fd479a01aaf7ae92bee896651dfc9d99ae422a296bf5d9f1ca49b2d96d82b79eb112d66")
+	require.NoError(t, err)
+
+	executions := map[string]func() error{
+		"ContractCreateFlow": func() error {
+			_, err := NewContractCreateFlow().
+				SetNodeAccountIDs([]AccountID{{Account: 3}}).
+				SetBytecode([]byte{1, 2, 3}).
+				ExecuteWithContext(ctx, client)
+			return err
+		},
+		"EthereumFlow": func() error {
+			_, err := NewEthereumFlow().
+				SetNodeAccountIDs([]AccountID{{Account: 3}}).
+				SetEthereumDataBytes(ethereumData).
+				ExecuteWithContext(ctx, client)
+			return err
+		},
+		"TokenRejectFlow": func() error {
+			_, err := NewTokenRejectFlow().
+				SetOwnerID(AccountID{Account: 5}).
+				SetTokenIDs(TokenID{Token: 6}).
+				ExecuteWithContext(ctx, client)
+			return err
+		},
+		"AddressBookQuery": func() error {
+			_, err := NewAddressBookQuery().
+				SetFileID(FileIDForAddressBook()).
+				ExecuteWithContext(ctx, client)
+			return err
+		},
+		"MirrorNodeContractCallQuery": func() error {
+			_, err := NewMirrorNodeContractCallQuery().
+				SetContractID(ContractID{Contract: 7}).
+				ExecuteWithContext(ctx, client)
+			return err
+		},
+		"MirrorNodeContractEstimateGasQuery": func() error {
+			_, err := NewMirrorNodeContractEstimateGasQuery().
+				SetContractID(ContractID{Contract: 7}).
+				ExecuteWithContext(ctx, client)
+			return err
+		},
+	}
+
+	for name, execute := range executions {
+		err := execute()
+
+		var ctxErr ErrContextDone
+		require.True(t, errors.As(err, &ctxErr), "%s: %v", name, err)
+		require.ErrorIs(t, err, context.Canceled, name)
+	}
+}
@@ -13,6 +13,7 @@ type FeeData struct {
 	NodeData    *FeeComponents
 	NetworkData *FeeComponents
 	ServiceData *FeeComponents
+	subType     services.SubType
 }
 
 func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
@@ -39,6 +40,7 @@ func _FeeDataFromProtobuf(feeData *services.FeeData) (FeeData, error) {
 		NodeData:    &nodeData,
 		NetworkData: &networkData,
 		ServiceData: &serviceData,
+		subType:     feeData.SubType,
 	}, nil
 }
 
@@ -62,6 +64,7 @@ func (feeData FeeData) _ToProtobuf() *services.FeeData {
 		Nodedata:    nodeData,
 		Networkdata: networkData,
 		Servicedata: serviceData,
+		SubType:     feeData.subType,
 	}
 }
 
@@ -0,0 +1,471 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Fee schedule prices are expressed in thousandths of a tinycent
+const _FeeDivisorFactor = 1000
+
+const (
+	_SecondsPerHour = 3600
+	// How long the network keeps receipts
+	_ReceiptStorageSeconds = 180
+	// Size of a serialized signature pair: a full public key prefix, the signature and the protobuf overhead
+	_SignaturePairSize = 100
+	// Sizes of the fixed parts of a receipt and of the entities whose storage is charged
+	_BasicReceiptSize  = 4 + 8*3
+	_TopicReceiptSize  = 8 + 48
+	_BasicEntitySize   = 24
+	_BasicAccountSize  = _BasicEntitySize + 8*8
+	_BasicTopicSize    = _BasicEntitySize + 8*3 + 48
+	_BasicTokenSize    = _BasicEntitySize + 8*6
+	_BasicFileSize     = _BasicEntitySize + 8*2
+	_BasicScheduleSize = _BasicEntitySize + 8*4
+	// Lifetimes assumed when a transaction doesn't say how long the entity it creates or changes lives
+	_DefaultAutoRenewPeriod    = 90 * 24 * time.Hour
+	_DefaultScheduleExpiration = 30 * time.Minute
+)
+
+// _FeeUsage is how much of each priced resource a transaction uses on the node, on the network and in the service
+type _FeeUsage struct {
+	subType services.SubType
+	node    FeeComponents
+	network FeeComponents
+	service FeeComponents
+}
+
+// EstimateFee estimates the fee the network will charge for tx, using the prices of schedule converted to hbar with
+// rate. The transaction must be frozen.
+//
+// The estimate follows the network's fee model: every fee schedule entry prices the resources a transaction uses on
+// the node that submits it, on the network and in the service that handles it. Usage is derived from the frozen body
+// (size, memo, keys, contents, lifetimes and gas) and from the signatures the transaction will carry; signatures not
+// added yet are counted as one payer signature. The result is rounded up to the next tinybar and is meant for
+// SetMaxTransactionFee and cost previews; the network may charge slightly less, since it measures usage exactly.
+func EstimateFee(tx TransactionInterface, schedule FeeSchedule, rate ExchangeRate) (Hbar, error) {
+	if tx == nil {
+		return Hbar{}, errParameterNull
+	}
+	if rate.Hbars <= 0 || rate.cents <= 0 {
+		return Hbar{}, errInvalidExchangeRate
+	}
+
+	baseTx := tx.getBaseTransaction()
+	if !baseTx.IsFrozen() {
+		return Hbar{}, errTransactionIsNotFrozen
+	}
+
+	signedTx := baseTx.signedTransactions._Get(0).(*services.SignedTransaction)
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(signedTx.GetBodyBytes(), &body); err != nil {
+		return Hbar{}, errors.Wrap(err, "failed to parse transaction body")
+	}
+
+	requestType := _RequestTypeFromTransactionBody(&body)
+	if requestType == RequestTypeNone {
+		return Hbar{}, fmt.Errorf("cannot estimate the fee of a transaction of type %T", body.GetData())
+	}
+
+	signatureCount := len(baseTx.publicKeys)
+	if signatureCount < len(signedTx.GetSigMap().GetSigPair()) {
+		signatureCount = len(signedTx.GetSigMap().GetSigPair())
+	}
+	if signatureCount == 0 {
+		signatureCount = 1
+	}
+
+	usage := _EstimateFeeUsage(&body, len(signedTx.GetBodyBytes())+signatureCount*_SignaturePairSize, signatureCount)
+
+	prices, err := schedule._FeeData(requestType, usage.subType)
+	if err != nil {
+		return Hbar{}, err
+	}
+
+	tinycents := new(big.Int)
+	for _, fee := range []int64{
+		_FeeInTinycents(prices.NodeData, usage.node),
+		_FeeInTinycents(prices.NetworkData, usage.network),
+		_FeeInTinycents(prices.ServiceData, usage.service),
+	} {
+		tinycents.Add(tinycents, big.NewInt(fee))
+	}
+
+	return HbarFromTinybar(_TinycentsToTinybars(tinycents, rate)), nil
+}
+
+// _FeeData returns the prices of requestType for subType, falling back to the default sub type
+func (feeSchedule FeeSchedule) _FeeData(requestType RequestType, subType services.SubType) (FeeData, error) {
+	for _, txFeeSchedule := range feeSchedule.TransactionFeeSchedules {
+		if txFeeSchedule.RequestType != requestType {
+			continue
+		}
+
+		var fallback *FeeData
+		for _, feeData := range txFeeSchedule.Fees {
+			if feeData == nil {
+				continue
+			}
+			if feeData.subType == subType {
+				return *feeData, nil
+			}
+			if feeData.subType == services.SubType_DEFAULT && fallback == nil {
+				fallback = feeData
+			}
+		}
+
+		if fallback == nil {
+			fallback = txFeeSchedule.FeeData // nolint
+		}
+		if fallback != nil {
+			return *fallback, nil
+		}
+	}
+
+	return FeeData{}, fmt.Errorf("fee schedule has no prices for %s", requestType.String())
+}
+
+// _FeeInTinycents prices usage, clamping the total to the minimum and maximum of the price
+func _FeeInTinycents(price *FeeComponents, usage FeeComponents) int64 {
+	if price == nil {
+		return 0
+	}
+
+	// Large schedules or usages overflow int64, so the sum is computed exactly and saturated at the maximum
+	sum := new(big.Int)
+	for _, term := range [][2]int64{
+		{price.Constant, usage.Constant},
+		{price.TransactionBandwidthByte, usage.TransactionBandwidthByte},
+		{price.TransactionVerification, usage.TransactionVerification},
+		{price.TransactionRamByteHour, usage.TransactionRamByteHour},
+		{price.TransactionStorageByteHour, usage.TransactionStorageByteHour},
+		{price.ContractTransactionGas, usage.ContractTransactionGas},
+		{price.TransferVolumeHbar, usage.TransferVolumeHbar},
+		{price.ResponseMemoryByte, usage.ResponseMemoryByte},
+		{price.ResponseDiscByte, usage.ResponseDiscByte},
+	} {
+		sum.Add(sum, new(big.Int).Mul(big.NewInt(term[0]), big.NewInt(term[1])))
+	}
+
+	maximum := int64(math.MaxInt64)
+	if price.Max > 0 {
+		maximum = price.Max
+	}
+
+	total := maximum
+	if sum.Cmp(big.NewInt(maximum)) < 0 {
+		total = sum.Int64()
+	}
+	if total < price.Min {
+		total = price.Min
+	}
+
+	return total / _FeeDivisorFactor
+}
+
+// _TinycentsToTinybars converts with the exchange rate, rounding up and saturating at the largest int64
+func _TinycentsToTinybars(tinycents *big.Int, rate ExchangeRate) int64 {
+	numerator := new(big.Int).Mul(tinycents, big.NewInt(int64(rate.Hbars)))
+	denominator := big.NewInt(int64(rate.cents))
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() > 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return quotient.Int64()
+}
+
+func _EstimateFeeUsage(body *services.TransactionBody, transactionSize int, signatureCount int) _FeeUsage {
+	usage := _FeeUsage{
+		node: FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: int64(transactionSize),
+			TransactionVerification:  1,
+			ResponseMemoryByte:       4,
+		},
+		network: FeeComponents{
+			Constant:                 1,
+			TransactionBandwidthByte: int64(transactionSize),
+			TransactionVerification:  int64(signatureCount),
+		},
+		service: FeeComponents{
+			Constant: 1,
+		},
+	}
+
+	validStart := time.Now()
+	if start := body.GetTransactionID().GetTransactionValidStart(); start != nil {
+		validStart = _TimeFromProtobuf(start)
+	}
+
+	receiptSize := _BasicReceiptSize
+	service := &usage.service
+
+	switch data := body.GetData().(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		size := _BasicAccountSize + protobuf.Size(data.CryptoCreateAccount.GetKey()) + len(data.CryptoCreateAccount.GetMemo())
+		service.TransactionRamByteHour = _ByteHours(size, _DurationOrDefault(data.CryptoCreateAccount.GetAutoRenewPeriod(), _DefaultAutoRenewPeriod))
+	case *services.TransactionBody_CryptoTransfer:
+		usage.subType = _TokenTransfersSubType(data.CryptoTransfer.GetTokenTransfers())
+	case *services.TransactionBody_TokenAirdrop:
+		usage.subType = _TokenTransfersSubType(data.TokenAirdrop.GetTokenTransfers())
+	case *services.TransactionBody_ConsensusCreateTopic:
+		topic := data.ConsensusCreateTopic
+		size := _BasicTopicSize + protobuf.Size(topic.GetAdminKey()) + protobuf.Size(topic.GetSubmitKey()) + len(topic.GetMemo())
+		service.TransactionRamByteHour = _ByteHours(size, _DurationOrDefault(topic.GetAutoRenewPeriod(), _DefaultAutoRenewPeriod))
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		receiptSize += _TopicReceiptSize
+	case *services.TransactionBody_FileCreate:
+		file := data.FileCreate
+		size := _BasicFileSize + len(file.GetContents()) + protobuf.Size(file.GetKeys()) + len(file.GetMemo())
+		service.TransactionStorageByteHour = _ByteHours(size, _Lifetime(validStart, file.GetExpirationTime()))
+	case *services.TransactionBody_FileUpdate:
+		file := data.FileUpdate
+		size := len(file.GetContents()) + protobuf.Size(file.GetKeys()) + len(file.GetMemo().GetValue())
+		service.TransactionStorageByteHour = _ByteHours(size, _Lifetime(validStart, file.GetExpirationTime()))
+	case *services.TransactionBody_FileAppend:
+		service.TransactionStorageByteHour = _ByteHours(len(data.FileAppend.GetContents()), _DefaultAutoRenewPeriod)
+	case *services.TransactionBody_TokenCreation:
+		token := data.TokenCreation
+		nonFungible := token.GetTokenType() == services.TokenType_NON_FUNGIBLE_UNIQUE
+		hasCustomFees := len(token.GetCustomFees()) > 0
+		switch {
+		case nonFungible && hasCustomFees:
+			usage.subType = services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE_WITH_CUSTOM_FEES
+		case nonFungible:
+			usage.subType = services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE
+		case hasCustomFees:
+			usage.subType = services.SubType_TOKEN_FUNGIBLE_COMMON_WITH_CUSTOM_FEES
+		default:
+			usage.subType = services.SubType_TOKEN_FUNGIBLE_COMMON
+		}
+		size := _BasicTokenSize + len(token.GetName()) + len(token.GetSymbol()) + len(token.GetMemo()) +
+			protobuf.Size(token.GetAdminKey()) + protobuf.Size(token.GetKycKey()) + protobuf.Size(token.GetFreezeKey()) +
+			protobuf.Size(token.GetWipeKey()) + protobuf.Size(token.GetSupplyKey()) + protobuf.Size(token.GetFeeScheduleKey()) +
+			protobuf.Size(token.GetPauseKey()) + protobuf.Size(token.GetMetadataKey()) + len(token.GetMetadata())
+		for _, fee := range token.GetCustomFees() {
+			size += protobuf.Size(fee)
+		}
+		service.TransactionRamByteHour = _ByteHours(size, _DurationOrDefault(token.GetAutoRenewPeriod(), _DefaultAutoRenewPeriod))
+	case *services.TransactionBody_TokenMint:
+		usage.subType = services.SubType_TOKEN_FUNGIBLE_COMMON
+		if len(data.TokenMint.GetMetadata()) > 0 {
+			usage.subType = services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE
+			size := 0
+			for _, metadata := range data.TokenMint.GetMetadata() {
+				size += _BasicEntitySize + len(metadata)
+			}
+			service.TransactionRamByteHour = _ByteHours(size, _DefaultAutoRenewPeriod)
+		}
+	case *services.TransactionBody_TokenBurn:
+		usage.subType = _SerialNumbersSubType(data.TokenBurn.GetSerialNumbers())
+	case *services.TransactionBody_TokenWipe:
+		usage.subType = _SerialNumbersSubType(data.TokenWipe.GetSerialNumbers())
+	case *services.TransactionBody_ScheduleCreate:
+		schedule := data.ScheduleCreate
+		if schedule.GetScheduledTransactionBody().GetContractCall() != nil {
+			usage.subType = services.SubType_SCHEDULE_CREATE_CONTRACT_CALL
+		}
+		size := _BasicScheduleSize + protobuf.Size(schedule.GetScheduledTransactionBody()) +
+			protobuf.Size(schedule.GetAdminKey()) + len(schedule.GetMemo())
+		lifetime := _DefaultScheduleExpiration
+		if schedule.GetExpirationTime() != nil {
+			lifetime = _Lifetime(validStart, schedule.GetExpirationTime())
+		}
+		service.TransactionRamByteHour = _ByteHours(size, lifetime)
+	case *services.TransactionBody_ContractCall:
+		service.ContractTransactionGas = data.ContractCall.GetGas()
+	case *services.TransactionBody_ContractCreateInstance:
+		service.ContractTransactionGas = data.ContractCreateInstance.GetGas()
+	case *services.TransactionBody_EthereumTransaction:
+		service.ContractTransactionGas = data.EthereumTransaction.GetMaxGasAllowance()
+	}
+
+	usage.network.TransactionRamByteHour = _ByteHours(receiptSize, _ReceiptStorageSeconds*time.Second)
+
+	return usage
+}
+
+// _ByteHours returns how many byte-hours storing size bytes for duration uses; any non-zero usage is at least one
+func _ByteHours(size int, duration time.Duration) int64 {
+	byteSeconds := int64(size) * int64(duration/time.Second)
+	if byteSeconds <= 0 {
+		return 0
+	}
+	if byteSeconds < _SecondsPerHour {
+		return 1
+	}
+
+	return byteSeconds / _SecondsPerHour
+}
+
+func _DurationOrDefault(duration *services.Duration, defaultDuration time.Duration) time.Duration {
+	if duration == nil || duration.Seconds <= 0 {
+		return defaultDuration
+	}
+
+	return time.Duration(duration.Seconds) * time.Second
+}
+
+// _Lifetime returns how long an entity expiring at expiration lives after start
+func _Lifetime(start time.Time, expiration *services.Timestamp) time.Duration {
+	if expiration == nil {
+		return _DefaultAutoRenewPeriod
+	}
+
+	lifetime := _TimeFromProtobuf(expiration).Sub(start)
+	if lifetime < 0 {
+		return 0
+	}
+
+	return lifetime
+}
+
+func _TokenTransfersSubType(tokenTransfers []*services.TokenTransferList) services.SubType {
+	subType := services.SubType_DEFAULT
+	for _, tokenTransfer := range tokenTransfers {
+		if len(tokenTransfer.GetNftTransfers()) > 0 {
+			return services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE
+		}
+		if len(tokenTransfer.GetTransfers()) > 0 {
+			subType = services.SubType_TOKEN_FUNGIBLE_COMMON
+		}
+	}
+
+	return subType
+}
+
+func _SerialNumbersSubType(serialNumbers []int64) services.SubType {
+	if len(serialNumbers) > 0 {
+		return services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE
+	}
+
+	return services.SubType_TOKEN_FUNGIBLE_COMMON
+}
+
+// _RequestTypeFromTransactionBody returns the functionality the fee schedule prices a transaction body under
+func _RequestTypeFromTransactionBody(body *services.TransactionBody) RequestType { // nolint
+	switch body.GetData().(type) {
+	case *services.TransactionBody_ContractCall:
+		return RequestTypeContractCall
+	case *services.TransactionBody_ContractCreateInstance:
+		return RequestTypeContractCreate
+	case *services.TransactionBody_ContractUpdateInstance:
+		return RequestTypeContractUpdate
+	case *services.TransactionBody_ContractDeleteInstance:
+		return RequestTypeContractDelete
+	case *services.TransactionBody_EthereumTransaction:
+		return RequestTypeEthereumTransaction
+	case *services.TransactionBody_CryptoAddLiveHash:
+		return RequestTypeCryptoAddLiveHash
+	case *services.TransactionBody_CryptoDeleteLiveHash:
+		return RequestTypeCryptoDeleteLiveHash
+	case *services.TransactionBody_CryptoCreateAccount:
+		return RequestTypeCryptoCreate
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return RequestTypeCryptoUpdate
+	case *services.TransactionBody_CryptoDelete:
+		return RequestTypeCryptoDelete
+	case *services.TransactionBody_CryptoTransfer:
+		return RequestTypeCryptoTransfer
+	case *services.TransactionBody_CryptoApproveAllowance:
+		return RequestTypeCryptoApproveAllowance
+	case *services.TransactionBody_CryptoDeleteAllowance:
+		return RequestTypeCryptoDeleteAllowance
+	case *services.TransactionBody_FileCreate:
+		return RequestTypeFileCreate
+	case *services.TransactionBody_FileAppend:
+		return RequestTypeFileAppend
+	case *services.TransactionBody_FileUpdate:
+		return RequestTypeFileUpdate
+	case *services.TransactionBody_FileDelete:
+		return RequestTypeFileDelete
+	case *services.TransactionBody_SystemDelete:
+		return RequestTypeSystemDelete
+	case *services.TransactionBody_SystemUndelete:
+		return RequestTypeSystemUndelete
+	case *services.TransactionBody_Freeze:
+		return RequestTypeFreeze
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return RequestTypeConsensusCreateTopic
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return RequestTypeConsensusUpdateTopic
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return RequestTypeConsensusDeleteTopic
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return RequestTypeConsensusSubmitMessage
+	case *services.TransactionBody_UncheckedSubmit:
+		return RequestTypeUncheckedSubmit
+	case *services.TransactionBody_TokenCreation:
+		return RequestTypeTokenCreate
+	case *services.TransactionBody_TokenFreeze:
+		return RequestTypeTokenFreezeAccount
+	case *services.TransactionBody_TokenUnfreeze:
+		return RequestTypeTokenUnfreezeAccount
+	case *services.TransactionBody_TokenGrantKyc:
+		return RequestTypeTokenGrantKycToAccount
+	case *services.TransactionBody_TokenRevokeKyc:
+		return RequestTypeTokenRevokeKycFromAccount
+	case *services.TransactionBody_TokenDeletion:
+		return RequestTypeTokenDelete
+	case *services.TransactionBody_TokenUpdate:
+		return RequestTypeTokenUpdate
+	case *services.TransactionBody_TokenMint:
+		return RequestTypeTokenMint
+	case *services.TransactionBody_TokenBurn:
+		return RequestTypeTokenBurn
+	case *services.TransactionBody_TokenWipe:
+		return RequestTypeTokenAccountWipe
+	case *services.TransactionBody_TokenAssociate:
+		return RequestTypeTokenAssociateToAccount
+	case *services.TransactionBody_TokenDissociate:
+		return RequestTypeTokenDissociateFromAccount
+	case *services.TransactionBody_TokenFeeScheduleUpdate:
+		return RequestTypeTokenFeeScheduleUpdate
+	case *services.TransactionBody_TokenPause:
+		return RequestTypeTokenPause
+	case *services.TransactionBody_TokenUnpause:
+		return RequestTypeTokenUnpause
+	case *services.TransactionBody_TokenUpdateNfts:
+		return RequestTypeTokenUpdateNfts
+	case *services.TransactionBody_TokenReject:
+		return RequestTypeTokenReject
+	case *services.TransactionBody_TokenAirdrop:
+		return RequestTypeTokenAirdrop
+	case *services.TransactionBody_TokenCancelAirdrop:
+		return RequestTypeTokenCancelAirdrop
+	case *services.TransactionBody_TokenClaimAirdrop:
+		return RequestTypeTokenClaimAirdrop
+	case *services.TransactionBody_ScheduleCreate:
+		return RequestTypeScheduleCreate
+	case *services.TransactionBody_ScheduleDelete:
+		return RequestTypeScheduleDelete
+	case *services.TransactionBody_ScheduleSign:
+		return RequestTypeScheduleSign
+	case *services.TransactionBody_NodeStakeUpdate:
+		return RequestTypeNodeStakeUpdate
+	case *services.TransactionBody_UtilPrng:
+		return RequestTypePrng
+	case *services.TransactionBody_NodeCreate:
+		return RequestTypeNodeCreate
+	case *services.TransactionBody_NodeUpdate:
+		return RequestTypeNodeUpdate
+	case *services.TransactionBody_NodeDelete:
+		return RequestTypeNodeDelete
+	}
+
+	return RequestTypeNone
+}
@@ -0,0 +1,247 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _ConstantFeeData(node int64, network int64, service int64) *FeeData {
+	return &FeeData{
+		NodeData:    &FeeComponents{Constant: node, Max: 1_000_000_000_000_000},
+		NetworkData: &FeeComponents{Constant: network, Max: 1_000_000_000_000_000},
+		ServiceData: &FeeComponents{Constant: service, Max: 1_000_000_000_000_000},
+	}
+}
+
+// _FeeTestTransactionID has a fixed valid start, so that bodies using it always have the same size
+func _FeeTestTransactionID() TransactionID {
+	validStart := time.Unix(1_700_000_000, 123_456_789)
+	return TransactionID{AccountID: &AccountID{Account: 1800}, ValidStart: &validStart}
+}
+
+func _FrozenTransfer(t *testing.T, memo string) *TransferTransaction {
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(_FeeTestTransactionID()).
+		SetTransactionMemo(memo).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 1801}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	return transfer
+}
+
+func TestUnitEstimateFeeConstant(t *testing.T) {
+	t.Parallel()
+
+	schedule := FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+		RequestType: RequestTypeCryptoTransfer,
+		Fees:        []*FeeData{_ConstantFeeData(100_000, 200_000, 300_000)},
+	}}}
+
+	// 600 tinycents at 1 hbar for 12 cents is 50 tinybars
+	fee, err := EstimateFee(_FrozenTransfer(t, ""), schedule, NewExchangeRate(1, 12))
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(50), fee)
+
+	// Rounded up to the next tinybar
+	fee, err = EstimateFee(_FrozenTransfer(t, ""), schedule, NewExchangeRate(1, 7))
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(86), fee)
+}
+
+func TestUnitEstimateFeeUsage(t *testing.T) {
+	t.Parallel()
+
+	feeData := _ConstantFeeData(0, 0, 0)
+	feeData.NodeData.TransactionBandwidthByte = 1000
+	feeData.NetworkData.TransactionVerification = 100_000
+	schedule := FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+		RequestType: RequestTypeCryptoTransfer,
+		Fees:        []*FeeData{feeData},
+	}}}
+	rate := NewExchangeRate(1, 1)
+
+	base, err := EstimateFee(_FrozenTransfer(t, ""), schedule, rate)
+	require.NoError(t, err)
+
+	// Every memo byte is a transaction byte, plus the tag and length of the memo field
+	withMemo, err := EstimateFee(_FrozenTransfer(t, strings.Repeat("a", 50)), schedule, rate)
+	require.NoError(t, err)
+	assert.Equal(t, base.AsTinybar()+52, withMemo.AsTinybar())
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	otherKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	// Each signature adds a signature pair and a verification
+	signed := _FrozenTransfer(t, "").Sign(key).Sign(otherKey)
+	withSignatures, err := EstimateFee(signed, schedule, rate)
+	require.NoError(t, err)
+	assert.Equal(t, base.AsTinybar()+_SignaturePairSize+100, withSignatures.AsTinybar())
+}
+
+func TestUnitEstimateFeeSubType(t *testing.T) {
+	t.Parallel()
+
+	fungible := _ConstantFeeData(0, 0, 12_000)
+	fungible.subType = services.SubType_TOKEN_FUNGIBLE_COMMON
+	nonFungible := _ConstantFeeData(0, 0, 24_000)
+	nonFungible.subType = services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE
+	schedule := FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+		RequestType: RequestTypeTokenMint,
+		Fees:        []*FeeData{_ConstantFeeData(0, 0, 1_000), fungible, nonFungible},
+	}}}
+
+	mint := func(metadata ...[]byte) *TokenMintTransaction {
+		tx := NewTokenMintTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			SetTokenID(TokenID{Token: 5})
+		if len(metadata) > 0 {
+			tx.SetMetadatas(metadata)
+		} else {
+			tx.SetAmount(10)
+		}
+		frozen, err := tx.Freeze()
+		require.NoError(t, err)
+		return frozen
+	}
+
+	fee, err := EstimateFee(mint(), schedule, NewExchangeRate(1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(12), fee)
+
+	fee, err = EstimateFee(mint([]byte("nft")), schedule, NewExchangeRate(1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(24), fee)
+}
+
+func TestUnitEstimateFeeTokenCreateSubType(t *testing.T) {
+	t.Parallel()
+
+	fees := make([]*FeeData, 0)
+	for service, subType := range map[int64]services.SubType{
+		1_000: services.SubType_TOKEN_FUNGIBLE_COMMON,
+		2_000: services.SubType_TOKEN_FUNGIBLE_COMMON_WITH_CUSTOM_FEES,
+		3_000: services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE,
+		4_000: services.SubType_TOKEN_NON_FUNGIBLE_UNIQUE_WITH_CUSTOM_FEES,
+	} {
+		feeData := _ConstantFeeData(0, 0, service)
+		feeData.subType = subType
+		fees = append(fees, feeData)
+	}
+	schedule := FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+		RequestType: RequestTypeTokenCreate,
+		Fees:        fees,
+	}}}
+
+	create := func(tokenType TokenType, customFees []Fee) *TokenCreateTransaction {
+		frozen, err := NewTokenCreateTransaction().
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			SetTransactionID(_FeeTestTransactionID()).
+			SetTreasuryAccountID(AccountID{Account: 1800}).
+			SetTokenType(tokenType).
+			SetCustomFees(customFees).
+			Freeze()
+		require.NoError(t, err)
+		return frozen
+	}
+	customFees := []Fee{NewCustomFixedFee().SetAmount(1).SetFeeCollectorAccountID(AccountID{Account: 1800})}
+
+	for expected, tx := range map[int64]*TokenCreateTransaction{
+		1: create(TokenTypeFungibleCommon, nil),
+		2: create(TokenTypeFungibleCommon, customFees),
+		3: create(TokenTypeNonFungibleUnique, nil),
+		4: create(TokenTypeNonFungibleUnique, customFees),
+	} {
+		fee, err := EstimateFee(tx, schedule, NewExchangeRate(1, 1))
+		require.NoError(t, err)
+		assert.Equal(t, HbarFromTinybar(expected), fee)
+	}
+}
+
+func TestUnitEstimateFeeSaturates(t *testing.T) {
+	t.Parallel()
+
+	price := &FeeComponents{TransactionBandwidthByte: math.MaxInt64 / 2, TransferVolumeHbar: math.MaxInt64 / 2, Max: 5_000_000_000}
+	usage := FeeComponents{TransactionBandwidthByte: 10, TransferVolumeHbar: 10}
+	assert.Equal(t, int64(5_000_000), _FeeInTinycents(price, usage))
+
+	price.Max = 0
+	assert.Equal(t, int64(math.MaxInt64/_FeeDivisorFactor), _FeeInTinycents(price, usage))
+
+	assert.Equal(t, int64(math.MaxInt64), _TinycentsToTinybars(big.NewInt(math.MaxInt64), NewExchangeRate(2, 1)))
+
+	// The three components add up without wrapping, and a sum too large in tinybars saturates
+	feeData := &FeeData{
+		NodeData:    &FeeComponents{Min: math.MaxInt64},
+		NetworkData: &FeeComponents{Min: math.MaxInt64},
+		ServiceData: &FeeComponents{Min: math.MaxInt64},
+	}
+	schedule := FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+		RequestType: RequestTypeCryptoTransfer,
+		Fees:        []*FeeData{feeData},
+	}}}
+	fee, err := EstimateFee(_FrozenTransfer(t, ""), schedule, NewExchangeRate(1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(3*(math.MaxInt64/_FeeDivisorFactor)), fee)
+
+	fee, err = EstimateFee(_FrozenTransfer(t, ""), schedule, NewExchangeRate(1000, 1))
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(math.MaxInt64), fee)
+}
+
+func TestUnitEstimateFeeNetworkSchedule(t *testing.T) {
+	t.Parallel()
+
+	// nolint
+	data, err := os.ReadFile("./fee_schedule/fee_schedule.pb")
+	require.NoError(t, err)
+	feeSchedules, err := FeeSchedulesFromBytes(data)
+	require.NoError(t, err)
+
+	// At 1 hbar for 12 cents, a transfer's 0.0001 USD is about 83,333 tinybars
+	fee, err := EstimateFee(_FrozenTransfer(t, ""), feeSchedules.GetCurrent(), NewExchangeRate(1, 12))
+	require.NoError(t, err)
+	assert.Greater(t, fee.AsTinybar(), int64(50_000))
+	assert.Less(t, fee.AsTinybar(), int64(150_000))
+}
+
+func TestUnitEstimateFeeErrors(t *testing.T) {
+	t.Parallel()
+
+	schedule := FeeSchedule{TransactionFeeSchedules: []TransactionFeeSchedule{{
+		RequestType: RequestTypeCryptoTransfer,
+		Fees:        []*FeeData{_ConstantFeeData(1, 1, 1)},
+	}}}
+
+	_, err := EstimateFee(NewTransferTransaction(), schedule, NewExchangeRate(1, 12))
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+
+	_, err = EstimateFee(_FrozenTransfer(t, ""), schedule, ExchangeRate{})
+	require.ErrorIs(t, err, errInvalidExchangeRate)
+
+	topic, err := NewTopicCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		Freeze()
+	require.NoError(t, err)
+	_, err = EstimateFee(topic, schedule, NewExchangeRate(1, 12))
+	require.ErrorContains(t, err, "fee schedule has no prices for")
+}
@@ -59,6 +59,24 @@ func (feeSchedules FeeSchedules) _ToProtobuf() *services.CurrentAndNextFeeSchedu
 	}
 }
 
+// GetCurrent returns the fee schedule in effect
+func (feeSchedules FeeSchedules) GetCurrent() FeeSchedule {
+	if feeSchedules.current == nil {
+		return FeeSchedule{}
+	}
+
+	return *feeSchedules.current
+}
+
+// GetNext returns the fee schedule taking effect when the current one expires
+func (feeSchedules FeeSchedules) GetNext() FeeSchedule {
+	if feeSchedules.next == nil {
+		return FeeSchedule{}
+	}
+
+	return *feeSchedules.next
+}
+
 // ToBytes returns the byte representation of the FeeSchedules
 func (feeSchedules FeeSchedules) ToBytes() []byte {
 	data, err := protobuf.Marshal(feeSchedules._ToProtobuf())
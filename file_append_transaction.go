@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -183,6 +184,14 @@ func (tx *FileAppendTransaction) FreezeWith(client *Client) (*FileAppendTransact
 // Execute executes the Transaction with the provided client
 func (tx *FileAppendTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping early if ctx is done
+func (tx *FileAppendTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -192,7 +201,7 @@ func (tx *FileAppendTransaction) Execute(
 		return TransactionResponse{}, tx.freezeError
 	}
 
-	list, err := tx.ExecuteAll(client)
+	list, err := tx.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		if len(list) > 0 {
@@ -214,6 +223,14 @@ func (tx *FileAppendTransaction) Execute(
 // ExecuteAll executes the all the Transactions with the provided client
 func (tx *FileAppendTransaction) ExecuteAll(
 	client *Client,
+) ([]TransactionResponse, error) {
+	return tx.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes the all the Transactions with the provided client, stopping early if ctx is done
+func (tx *FileAppendTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
 ) ([]TransactionResponse, error) {
 	if client == nil || client.operator == nil {
 		return []TransactionResponse{}, errNoClientProvided
@@ -234,17 +251,18 @@ func (tx *FileAppendTransaction) ExecuteAll(
 	}
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		if client.operator.signer == nil {
+			return []TransactionResponse{}, errNilSigner
+		}
+
+		tx.SignWithSigner(client.operator.signer)
 	}
 
 	size := tx.signedTransactions._Length() / tx.nodeAccountIDs._Length()
 	list := make([]TransactionResponse, size)
 
 	for i := 0; i < size; i++ {
-		resp, err := _Execute(client, tx)
+		resp, err := _Execute(ctx, client, tx)
 
 		if err != nil {
 			return list, err
@@ -252,7 +270,7 @@ func (tx *FileAppendTransaction) ExecuteAll(
 
 		list[i] = resp.(TransactionResponse)
 
-		_, err = list[i].SetValidateStatus(false).GetReceipt(client)
+		_, err = list[i].SetValidateStatus(false).GetReceiptWithContext(ctx, client)
 		if err != nil {
 			return list, err
 		}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -49,7 +50,12 @@ func (q *FileContentsQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *FileContentsQuery) Execute(client *Client) ([]byte, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *FileContentsQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]byte, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return []byte{}, err
@@ -82,6 +88,12 @@ func (q *FileContentsQuery) SetMaxRetry(count int) *FileContentsQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this FileContentsQuery.
+func (q *FileContentsQuery) SetRetryPolicy(policy RetryPolicy) *FileContentsQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *FileContentsQuery) SetMaxBackoff(max time.Duration) *FileContentsQuery {
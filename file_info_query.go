@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -53,7 +54,12 @@ func (q *FileInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *FileInfoQuery) Execute(client *Client) (FileInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *FileInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (FileInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return FileInfo{}, err
@@ -91,6 +97,12 @@ func (q *FileInfoQuery) SetMaxRetry(count int) *FileInfoQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this FileInfoQuery.
+func (q *FileInfoQuery) SetRetryPolicy(policy RetryPolicy) *FileInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *FileInfoQuery) SetMaxBackoff(max time.Duration) *FileInfoQuery {
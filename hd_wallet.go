@@ -0,0 +1,552 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"golang.org/x/crypto/ripemd160" // nolint
+)
+
+// DefaultHDWalletAccountPath is the BIP-44 path of the keys enumerated by HDWallet.Accounts and HDWallet.Recover when
+// no other path is given; the account index is appended to it.
+const DefaultHDWalletAccountPath = "m/44'/3030'/0'/0"
+
+const extendedKeyLength = 78
+
+var (
+	xprvVersion = []byte{0x04, 0x88, 0xad, 0xe4}
+	xpubVersion = []byte{0x04, 0x88, 0xb2, 0x1e}
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// HDWallet is a hierarchical deterministic wallet which derives keys from a single seed along BIP-32 derivation
+// paths, such as `m/44'/3030'/0'/0/0`.
+//
+// ECDSA secp256k1 wallets follow BIP-32 and support both hardened and non-hardened indices. Ed25519 wallets follow
+// SLIP-10, which only defines hardened derivation, so every index of their paths must be hardened.
+//
+// A wallet created from an xpub with HDWalletFromExtendedKey is watch-only: it derives the public keys of
+// non-hardened children but no private keys.
+type HDWallet struct {
+	master *ExtendedKey
+}
+
+// HDWalletAccount is a key of an HDWallet together with its derivation path
+type HDWalletAccount struct {
+	// Path is the derivation path of the key, relative to the root of the wallet
+	Path string
+	// Index is the last index of Path
+	Index uint32
+	// Key is the derived extended key; it only holds a private key if the wallet does
+	Key *ExtendedKey
+}
+
+// ExtendedKey is a private or public key together with the chain code and position needed to derive its children.
+// ECDSA secp256k1 extended keys can be serialized as xprv and xpub strings.
+type ExtendedKey struct {
+	privateKey        *PrivateKey
+	publicKey         PublicKey
+	chainCode         []byte
+	depth             uint8
+	parentFingerprint []byte
+	childIndex        uint32
+}
+
+// NewHDWalletEd25519 creates an Ed25519 HDWallet from a BIP-39 seed, following SLIP-10
+func NewHDWalletEd25519(seed []byte) (*HDWallet, error) {
+	key, err := _Ed25519PrivateKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDWallet{
+		master: _NewMasterExtendedKey(PrivateKey{ed25519PrivateKey: key}),
+	}, nil
+}
+
+// NewHDWalletECDSAsecp256k1 creates an ECDSA secp256k1 HDWallet from a BIP-39 seed, following BIP-32
+func NewHDWalletECDSAsecp256k1(seed []byte) (*HDWallet, error) {
+	key, err := _ECDSAPrivateKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDWallet{
+		master: _NewMasterExtendedKey(PrivateKey{ecdsaPrivateKey: key}),
+	}, nil
+}
+
+// HDWalletFromExtendedKey creates an ECDSA secp256k1 HDWallet rooted at an xprv or xpub. Paths are relative to that
+// key. A wallet created from an xpub is watch-only.
+func HDWalletFromExtendedKey(s string) (*HDWallet, error) {
+	key, err := ExtendedKeyFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDWallet{
+		master: key,
+	}, nil
+}
+
+// ToHDWalletEd25519 creates an Ed25519 HDWallet from the seed of the mnemonic
+func (m Mnemonic) ToHDWalletEd25519(passPhrase string) (*HDWallet, error) {
+	return NewHDWalletEd25519(m._ToSeed(passPhrase))
+}
+
+// ToHDWalletECDSAsecp256k1 creates an ECDSA secp256k1 HDWallet from the seed of the mnemonic
+func (m Mnemonic) ToHDWalletECDSAsecp256k1(passPhrase string) (*HDWallet, error) {
+	return NewHDWalletECDSAsecp256k1(m._ToSeed(passPhrase))
+}
+
+// MasterKey returns the extended key at the root of the wallet
+func (w *HDWallet) MasterKey() *ExtendedKey {
+	return w.master
+}
+
+// IsWatchOnly returns true if the wallet only holds public keys
+func (w *HDWallet) IsWatchOnly() bool {
+	return !w.master.IsPrivate()
+}
+
+// DeriveExtendedKey derives the extended key at a derivation path such as `m/44'/3030'/0'/0/0`
+func (w *HDWallet) DeriveExtendedKey(derivationPath string) (*ExtendedKey, error) {
+	return w.master.DerivePath(derivationPath)
+}
+
+// DerivePrivateKey derives the private key at a derivation path. It fails for watch-only wallets.
+func (w *HDWallet) DerivePrivateKey(derivationPath string) (PrivateKey, error) {
+	key, err := w.master.DerivePath(derivationPath)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return key.PrivateKey()
+}
+
+// DerivePublicKey derives the public key at a derivation path
+func (w *HDWallet) DerivePublicKey(derivationPath string) (PublicKey, error) {
+	key, err := w.master.DerivePath(derivationPath)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	return key.PublicKey(), nil
+}
+
+// Accounts derives count consecutive keys starting at index start below basePath, for example
+// `m/44'/3030'/0'/0/5` through `m/44'/3030'/0'/0/9`. An empty basePath means DefaultHDWalletAccountPath. Ed25519
+// wallets harden every index, so that their default keys are the ones of Mnemonic.ToStandardEd25519PrivateKey.
+func (w *HDWallet) Accounts(basePath string, start uint32, count uint32) ([]HDWalletAccount, error) {
+	if basePath == "" {
+		basePath = DefaultHDWalletAccountPath
+	}
+
+	if uint64(start)+uint64(count) > uint64(hardenedBit) {
+		return nil, _NewErrBadKeyf("account indices must be below %v", hardenedBit)
+	}
+
+	basePathIndices, err := _ParseDerivationPath(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if w.master._IsEd25519() {
+		for i, index := range basePathIndices {
+			basePathIndices[i] = ToHardenedIndex(index)
+		}
+	}
+
+	base := w.master
+	for _, index := range basePathIndices {
+		if base, err = base.Derive(index); err != nil {
+			return nil, err
+		}
+	}
+
+	accounts := make([]HDWalletAccount, 0, count)
+	for index := start; index < start+count; index++ {
+		childIndex := index
+		if w.master._IsEd25519() {
+			childIndex = ToHardenedIndex(index)
+		}
+
+		key, err := base.Derive(childIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, HDWalletAccount{
+			Path:  _FormatDerivationPath(append(append([]uint32{}, basePathIndices...), childIndex)),
+			Index: index,
+			Key:   key,
+		})
+	}
+
+	return accounts, nil
+}
+
+// Recover scans the keys below basePath in order, as BIP-44 account discovery does, and returns the ones isUsed
+// reports as used. The scan stops after gapLimit consecutive unused keys; BIP-44 recommends a gap limit of 20. An
+// empty basePath means DefaultHDWalletAccountPath.
+//
+// isUsed would usually look the public key up on a mirror node. Its errors stop the scan and are returned.
+func (w *HDWallet) Recover(basePath string, gapLimit uint32, isUsed func(account HDWalletAccount) (bool, error)) ([]HDWalletAccount, error) {
+	if gapLimit == 0 {
+		return nil, _NewErrBadKeyf("gap limit must be greater than 0")
+	}
+
+	used := make([]HDWalletAccount, 0)
+	var gap uint32
+	for index := uint32(0); index < hardenedBit && gap < gapLimit; index++ {
+		accounts, err := w.Accounts(basePath, index, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		isAccountUsed, err := isUsed(accounts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if isAccountUsed {
+			used = append(used, accounts[0])
+			gap = 0
+		} else {
+			gap++
+		}
+	}
+
+	return used, nil
+}
+
+// ExtendedKeyFromString parses an xprv or xpub string. Only ECDSA secp256k1 keys have such a serialization.
+func ExtendedKeyFromString(s string) (*ExtendedKey, error) {
+	data, err := _Base58CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != extendedKeyLength {
+		return nil, _NewErrBadKeyf("invalid extended key length: %v bytes", len(data))
+	}
+
+	version, keyData := data[0:4], data[45:78]
+	key := &ExtendedKey{
+		depth:             data[4],
+		parentFingerprint: append([]byte{}, data[5:9]...),
+		childIndex:        binary.BigEndian.Uint32(data[9:13]),
+		chainCode:         append([]byte{}, data[13:45]...),
+	}
+
+	if key.depth == 0 && (key.childIndex != 0 || !bytes.Equal(key.parentFingerprint, make([]byte, 4))) {
+		return nil, _NewErrBadKeyf("invalid extended key: a master key must not have a parent")
+	}
+
+	switch {
+	case bytes.Equal(version, xprvVersion):
+		if keyData[0] != 0 {
+			return nil, _NewErrBadKeyf("invalid extended private key")
+		}
+
+		privateKey, err := _ECDSAPrivateKeyFromBytesRaw(keyData[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		scalar := new(big.Int).SetBytes(keyData[1:])
+		if scalar.Sign() == 0 || scalar.Cmp(privateKey.keyData.ToECDSA().Curve.Params().N) >= 0 {
+			return nil, _NewErrBadKeyf("invalid extended private key")
+		}
+
+		privateKey.chainCode = key.chainCode
+		key.privateKey = &PrivateKey{ecdsaPrivateKey: privateKey}
+		key.publicKey = key.privateKey.PublicKey()
+	case bytes.Equal(version, xpubVersion):
+		publicKey, err := _ECDSAPublicKeyFromBytesRaw(keyData)
+		if err != nil {
+			return nil, err
+		}
+
+		key.publicKey = PublicKey{ecdsaPublicKey: publicKey}
+	default:
+		return nil, _NewErrBadKeyf("unsupported extended key version: %x", version)
+	}
+
+	return key, nil
+}
+
+func _NewMasterExtendedKey(privateKey PrivateKey) *ExtendedKey {
+	var chainCode []byte
+	if privateKey.ed25519PrivateKey != nil {
+		chainCode = privateKey.ed25519PrivateKey.chainCode
+	} else {
+		chainCode = privateKey.ecdsaPrivateKey.chainCode
+	}
+
+	return &ExtendedKey{
+		privateKey:        &privateKey,
+		publicKey:         privateKey.PublicKey(),
+		chainCode:         chainCode,
+		parentFingerprint: make([]byte, 4),
+	}
+}
+
+// IsPrivate returns true if the extended key holds a private key
+func (k *ExtendedKey) IsPrivate() bool {
+	return k.privateKey != nil
+}
+
+// PrivateKey returns the private key, which also carries the chain code so that PrivateKey.Derive works on it
+func (k *ExtendedKey) PrivateKey() (PrivateKey, error) {
+	if k.privateKey == nil {
+		return PrivateKey{}, _NewErrBadKeyf("extended key is public only")
+	}
+
+	return *k.privateKey, nil
+}
+
+// PublicKey returns the public key
+func (k *ExtendedKey) PublicKey() PublicKey {
+	return k.publicKey
+}
+
+// ChainCode returns the chain code
+func (k *ExtendedKey) ChainCode() []byte {
+	return append([]byte{}, k.chainCode...)
+}
+
+// Depth returns the number of derivations between the master key and this key
+func (k *ExtendedKey) Depth() uint8 {
+	return k.depth
+}
+
+// ChildIndex returns the index this key was derived at, or 0 for a master key
+func (k *ExtendedKey) ChildIndex() uint32 {
+	return k.childIndex
+}
+
+// ParentFingerprint returns the fingerprint of the parent key, or zeros for a master key
+func (k *ExtendedKey) ParentFingerprint() []byte {
+	return append([]byte{}, k.parentFingerprint...)
+}
+
+// Fingerprint returns the first 4 bytes of the HASH160 of the public key, which identifies this key as the parent of
+// its children
+func (k *ExtendedKey) Fingerprint() []byte {
+	sha := sha256.Sum256(k._SerializedPublicKey())
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+
+	return hasher.Sum(nil)[:4]
+}
+
+// Neuter returns the public only version of the extended key, which can be shared with watch-only wallets
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	return &ExtendedKey{
+		publicKey:         k.publicKey,
+		chainCode:         k.chainCode,
+		depth:             k.depth,
+		parentFingerprint: k.parentFingerprint,
+		childIndex:        k.childIndex,
+	}
+}
+
+// Derive derives the child at index; use ToHardenedIndex for hardened children. Ed25519 keys only have hardened
+// children and public only keys only have non-hardened children.
+func (k *ExtendedKey) Derive(index uint32) (*ExtendedKey, error) {
+	if k.depth == 255 {
+		return nil, _NewErrBadKeyf("cannot derive beyond a depth of 255")
+	}
+
+	child := &ExtendedKey{
+		depth:             k.depth + 1,
+		parentFingerprint: k.Fingerprint(),
+		childIndex:        index,
+	}
+
+	switch {
+	case k._IsEd25519():
+		if !IsHardenedIndex(index) {
+			return nil, _NewErrBadKeyf("ed25519 keys only support hardened derivation")
+		}
+		if k.privateKey == nil {
+			return nil, _NewErrBadKeyf("ed25519 child keys cannot be derived from a public key")
+		}
+
+		keyBytes, chainCode, err := _DeriveEd25519ChildKey(k.privateKey.ed25519PrivateKey._BytesRaw(), k.chainCode, index&^hardenedBit)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, err := _Ed25519PrivateKeyFromBytes(keyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey.chainCode = chainCode
+		child.privateKey = &PrivateKey{ed25519PrivateKey: privateKey}
+		child.publicKey = child.privateKey.PublicKey()
+		child.chainCode = chainCode
+	case k.privateKey != nil:
+		keyBytes, chainCode, err := _DeriveECDSAChildKey(k.privateKey.ecdsaPrivateKey._BytesRaw(), k.chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, err := _ECDSAPrivateKeyFromBytesRaw(keyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey.chainCode = chainCode
+		child.privateKey = &PrivateKey{ecdsaPrivateKey: privateKey}
+		child.publicKey = child.privateKey.PublicKey()
+		child.chainCode = chainCode
+	default:
+		keyBytes, chainCode, err := _DeriveECDSAPublicChildKey(k.publicKey.ecdsaPublicKey._BytesRaw(), k.chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey, err := _ECDSAPublicKeyFromBytesRaw(keyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		child.publicKey = PublicKey{ecdsaPublicKey: publicKey}
+		child.chainCode = chainCode
+	}
+
+	return child, nil
+}
+
+// DerivePath derives the key at a derivation path relative to this key, such as `m/0'/1` or `0'/1`
+func (k *ExtendedKey) DerivePath(derivationPath string) (*ExtendedKey, error) {
+	indices, err := _ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	key := k
+	for _, index := range indices {
+		if key, err = key.Derive(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// Xprv returns the BIP-32 serialization of the extended private key. It fails for Ed25519 keys, which have no
+// standard serialization, and for public only keys.
+func (k *ExtendedKey) Xprv() (string, error) {
+	if k._IsEd25519() {
+		return "", _NewErrBadKeyf("ed25519 extended keys cannot be serialized")
+	}
+	if k.privateKey == nil {
+		return "", _NewErrBadKeyf("extended key is public only")
+	}
+
+	return _Base58CheckEncode(k._Serialize(xprvVersion, append([]byte{0}, k.privateKey.ecdsaPrivateKey._BytesRaw()...))), nil
+}
+
+// Xpub returns the BIP-32 serialization of the extended public key. It fails for Ed25519 keys, which have no
+// standard serialization.
+func (k *ExtendedKey) Xpub() (string, error) {
+	if k._IsEd25519() {
+		return "", _NewErrBadKeyf("ed25519 extended keys cannot be serialized")
+	}
+
+	return _Base58CheckEncode(k._Serialize(xpubVersion, k.publicKey.ecdsaPublicKey._BytesRaw())), nil
+}
+
+func (k *ExtendedKey) _IsEd25519() bool {
+	return k.publicKey.ed25519PublicKey != nil
+}
+
+// _SerializedPublicKey returns the 33 byte public key used for fingerprints; SLIP-10 prefixes Ed25519 keys with 0
+func (k *ExtendedKey) _SerializedPublicKey() []byte {
+	if k._IsEd25519() {
+		return append([]byte{0}, k.publicKey.ed25519PublicKey._BytesRaw()...)
+	}
+
+	return k.publicKey.ecdsaPublicKey._BytesRaw()
+}
+
+func (k *ExtendedKey) _Serialize(version []byte, keyData []byte) []byte {
+	data := make([]byte, 0, extendedKeyLength)
+	data = append(data, version...)
+	data = append(data, k.depth)
+	data = append(data, k.parentFingerprint...)
+	data = binary.BigEndian.AppendUint32(data, k.childIndex)
+	data = append(data, k.chainCode...)
+
+	return append(data, keyData...)
+}
+
+func _Base58CheckEncode(data []byte) string {
+	first := sha256.Sum256(data)
+	checksum := sha256.Sum256(first[:])
+	data = append(append([]byte{}, data...), checksum[:4]...)
+
+	num := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	encoded := make([]byte, 0, len(data)*138/100+1)
+	for num.Sign() > 0 {
+		num.DivMod(num, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+
+	// Leading zero bytes are encoded as leading 1s
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+func _Base58CheckDecode(s string) ([]byte, error) {
+	num := new(big.Int)
+	radix := big.NewInt(58)
+	zeros := 0
+	for i := 0; i < len(s); i++ {
+		digit := bytes.IndexByte([]byte(base58Alphabet), s[i])
+		if digit < 0 {
+			return nil, _NewErrBadKeyf("invalid base58 character %q", s[i])
+		}
+		if digit == 0 && num.Sign() == 0 {
+			zeros++
+		}
+
+		num.Mul(num, radix)
+		num.Add(num, big.NewInt(int64(digit)))
+	}
+
+	data := append(make([]byte, zeros), num.Bytes()...)
+	if len(data) < 4 {
+		return nil, _NewErrBadKeyf("invalid base58check data")
+	}
+
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(checksum, second[:4]) {
+		return nil, _NewErrBadKeyf("invalid base58check checksum")
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,285 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vector 1 of BIP-32 and SLIP-10
+const hdWalletTestSeed = "000102030405060708090a0b0c0d0e0f"
+
+func TestUnitHDWalletECDSAsecp256k1Bip32Vectors(t *testing.T) {
+	t.Parallel()
+
+	seed, err := hex.DecodeString(hdWalletTestSeed)
+	require.NoError(t, err)
+
+	wallet, err := NewHDWalletECDSAsecp256k1(seed)
+	require.NoError(t, err)
+
+	vectors := []struct {
+		path string
+		xprv string
+		xpub string
+	}{
+		{
+			path: "m",
+			xprv: "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			xpub: "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+		},
+		{
+			path: "m/0'",
+			xprv: "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7",
+			xpub: "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+		},
+		{
+			path: "m/0'/1/2'/2/1000000000",
+			xprv: "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76",
+			xpub: "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+		},
+	}
+
+	for _, vector := range vectors {
+		key, err := wallet.DeriveExtendedKey(vector.path)
+		require.NoError(t, err)
+
+		xprv, err := key.Xprv()
+		require.NoError(t, err)
+		assert.Equal(t, vector.xprv, xprv, vector.path)
+
+		xpub, err := key.Xpub()
+		require.NoError(t, err)
+		assert.Equal(t, vector.xpub, xpub, vector.path)
+
+		parsed, err := ExtendedKeyFromString(vector.xprv)
+		require.NoError(t, err)
+		assert.Equal(t, key.Depth(), parsed.Depth())
+		assert.Equal(t, key.ChildIndex(), parsed.ChildIndex())
+		assert.Equal(t, key.ParentFingerprint(), parsed.ParentFingerprint())
+		assert.Equal(t, key.ChainCode(), parsed.ChainCode())
+		assert.Equal(t, key.PublicKey().StringRaw(), parsed.PublicKey().StringRaw())
+	}
+}
+
+func TestUnitHDWalletWatchOnly(t *testing.T) {
+	t.Parallel()
+
+	seed, err := hex.DecodeString(hdWalletTestSeed)
+	require.NoError(t, err)
+
+	wallet, err := NewHDWalletECDSAsecp256k1(seed)
+	require.NoError(t, err)
+
+	account, err := wallet.DeriveExtendedKey("m/44'/3030'/0'")
+	require.NoError(t, err)
+	xpub, err := account.Xpub()
+	require.NoError(t, err)
+
+	watchOnly, err := HDWalletFromExtendedKey(xpub)
+	require.NoError(t, err)
+	assert.True(t, watchOnly.IsWatchOnly())
+	assert.False(t, wallet.IsWatchOnly())
+
+	// Public derivation gives the same keys as private derivation
+	for _, path := range []string{"m/0/0", "m/0/7", "m/1/3"} {
+		publicKey, err := watchOnly.DerivePublicKey(path)
+		require.NoError(t, err)
+
+		privateKey, err := wallet.DerivePrivateKey("m/44'/3030'/0'" + path[1:])
+		require.NoError(t, err)
+		assert.Equal(t, privateKey.PublicKey().StringRaw(), publicKey.StringRaw(), path)
+	}
+
+	_, err = watchOnly.DerivePublicKey("m/0'")
+	require.Error(t, err)
+	_, err = watchOnly.DerivePrivateKey("m/0/0")
+	require.ErrorContains(t, err, "extended key is public only")
+
+	child, err := watchOnly.DeriveExtendedKey("m/0")
+	require.NoError(t, err)
+	_, err = child.Xprv()
+	require.Error(t, err)
+	childXpub, err := child.Xpub()
+	require.NoError(t, err)
+	expected, err := wallet.DeriveExtendedKey("m/44'/3030'/0'/0")
+	require.NoError(t, err)
+	expectedXpub, err := expected.Neuter().Xpub()
+	require.NoError(t, err)
+	assert.Equal(t, expectedXpub, childXpub)
+}
+
+func TestUnitHDWalletEd25519Slip10Vectors(t *testing.T) {
+	t.Parallel()
+
+	seed, err := hex.DecodeString(hdWalletTestSeed)
+	require.NoError(t, err)
+
+	wallet, err := NewHDWalletEd25519(seed)
+	require.NoError(t, err)
+
+	key, err := wallet.DeriveExtendedKey("m/0'")
+	require.NoError(t, err)
+	privateKey, err := key.PrivateKey()
+	require.NoError(t, err)
+	assert.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", privateKey.StringRaw())
+	assert.Equal(t, "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", hex.EncodeToString(key.ChainCode()))
+
+	key, err = wallet.DeriveExtendedKey("m/0h/1h/2h/2h/1000000000h")
+	require.NoError(t, err)
+	privateKey, err = key.PrivateKey()
+	require.NoError(t, err)
+	assert.Equal(t, "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", privateKey.StringRaw())
+	assert.Equal(t, "68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230", hex.EncodeToString(key.ChainCode()))
+	assert.Equal(t, uint8(5), key.Depth())
+
+	_, err = wallet.DeriveExtendedKey("m/0'/1")
+	require.ErrorContains(t, err, "ed25519 keys only support hardened derivation")
+	_, err = key.Xprv()
+	require.Error(t, err)
+	_, err = key.Xpub()
+	require.Error(t, err)
+}
+
+func TestUnitHDWalletMnemonicAccounts(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+
+	ed25519Wallet, err := mnemonic.ToHDWalletEd25519("")
+	require.NoError(t, err)
+
+	accounts, err := ed25519Wallet.Accounts("", 0, 3)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	for _, account := range accounts {
+		expected, err := mnemonic.ToStandardEd25519PrivateKey("", account.Index)
+		require.NoError(t, err)
+
+		privateKey, err := account.Key.PrivateKey()
+		require.NoError(t, err)
+		assert.Equal(t, expected.StringRaw(), privateKey.StringRaw())
+	}
+	assert.Equal(t, "m/44'/3030'/0'/0'/2'", accounts[2].Path)
+
+	ecdsaWallet, err := mnemonic.ToHDWalletECDSAsecp256k1("")
+	require.NoError(t, err)
+
+	accounts, err = ecdsaWallet.Accounts("m/44'/60'/0'/0", 1, 2)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "m/44'/60'/0'/0/1", accounts[0].Path)
+	assert.Equal(t, "77ca263661ebdd5a8b33c224aeff5e7bf67eedacee68a1699d97ee8929d7b130", accounts[0].Key.privateKey.StringRaw())
+	assert.Equal(t, "31c24292eac951279b659c335e44a2e812d0f1a228b1d4d87034874d376e605a", accounts[1].Key.privateKey.StringRaw())
+
+	for index := uint32(0); index < 3; index++ {
+		expected, err := mnemonic.ToStandardECDSAsecp256k1PrivateKey("", index)
+		require.NoError(t, err)
+
+		privateKey, err := ecdsaWallet.DerivePrivateKey(_FormatDerivationPath([]uint32{ToHardenedIndex(44), ToHardenedIndex(3030), ToHardenedIndex(0), 0, index}))
+		require.NoError(t, err)
+		assert.Equal(t, expected.StringRaw(), privateKey.StringRaw())
+	}
+}
+
+func TestUnitHDWalletRecover(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+
+	wallet, err := mnemonic.ToHDWalletECDSAsecp256k1("")
+	require.NoError(t, err)
+
+	accounts, err := wallet.Accounts("", 0, 10)
+	require.NoError(t, err)
+
+	used := map[string]bool{
+		accounts[0].Key.PublicKey().String(): true,
+		accounts[3].Key.PublicKey().String(): true,
+		accounts[9].Key.PublicKey().String(): true,
+	}
+
+	scanned := 0
+	recovered, err := wallet.Recover("", 5, func(account HDWalletAccount) (bool, error) {
+		scanned++
+		return used[account.Key.PublicKey().String()], nil
+	})
+	require.NoError(t, err)
+
+	// The gap between indices 3 and 9 is larger than the gap limit
+	require.Len(t, recovered, 2)
+	assert.Equal(t, uint32(0), recovered[0].Index)
+	assert.Equal(t, uint32(3), recovered[1].Index)
+	assert.Equal(t, 9, scanned)
+
+	_, err = wallet.Recover("", 0, nil)
+	require.Error(t, err)
+}
+
+func TestUnitExtendedKeyFromStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ExtendedKeyFromString("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet9")
+	require.ErrorContains(t, err, "checksum")
+
+	_, err = ExtendedKeyFromString("xpub0OIl")
+	require.ErrorContains(t, err, "invalid base58 character")
+
+	// A valid base58check string which is not an extended key
+	_, err = ExtendedKeyFromString(_Base58CheckEncode([]byte{1, 2, 3}))
+	require.ErrorContains(t, err, "invalid extended key length")
+
+	_, err = _ParseDerivationPath("m/44'/x")
+	require.Error(t, err)
+	_, err = _ParseDerivationPath("m/2147483648")
+	require.Error(t, err)
+
+	indices, err := _ParseDerivationPath("m/44'/3030H/0h/1")
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{ToHardenedIndex(44), ToHardenedIndex(3030), ToHardenedIndex(0), 1}, indices)
+	assert.Equal(t, "m/44'/3030'/0'/1", _FormatDerivationPath(indices))
+}
+
+func TestUnitECDSADerivationLeadingZeroByte(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(testMnemonic)
+	require.NoError(t, err)
+
+	// The master key of this mnemonic and passphrase starts with a zero byte
+	ecdsaWallet, err := mnemonic.ToHDWalletECDSAsecp256k1("297")
+	require.NoError(t, err)
+	require.Equal(t, byte(0), ecdsaWallet.MasterKey().privateKey.ecdsaPrivateKey._BytesRaw()[0])
+
+	expected, err := ecdsaWallet.DerivePrivateKey(_FormatDerivationPath([]uint32{ToHardenedIndex(44), ToHardenedIndex(3030), ToHardenedIndex(0), 0, 0}))
+	require.NoError(t, err)
+
+	privateKey, err := mnemonic.ToStandardECDSAsecp256k1PrivateKey("297", 0)
+	require.NoError(t, err)
+	assert.Equal(t, expected.StringRaw(), privateKey.StringRaw())
+
+	privateKey, err = mnemonic.ToStandardECDSAsecp256k1PrivateKeyCustomDerivationPath("297", "m/44'/3030'/0'/0/0")
+	require.NoError(t, err)
+	assert.Equal(t, expected.StringRaw(), privateKey.StringRaw())
+
+	// The child at index 564 starts with a zero byte and can be derived from further
+	parent, err := PrivateKeyFromStringECDSA("a6b9cd4a3e3b0f9a8b1ae52b0c1e09c30bf4d1b1e4d97ae3d1d27b4f6c4e8f2a")
+	require.NoError(t, err)
+	parent.ecdsaPrivateKey.chainCode = make([]byte, 32)
+
+	child, err := parent.Derive(564)
+	require.NoError(t, err)
+	assert.Equal(t, "00215064d3eb073ecae72de796935963201ca7cc7867bfd402b32b7d71fdd937", child.StringRaw())
+
+	_, err = child.Derive(0)
+	require.NoError(t, err)
+}
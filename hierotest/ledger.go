@@ -0,0 +1,288 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc"
+)
+
+// DefaultOperatorBalance is the balance the operator account starts with on a Ledger.
+var DefaultOperatorBalance = hiero.NewHbar(50_000_000)
+
+// _FirstEntityNum is the number of the first entity created on a Ledger
+const _FirstEntityNum = 1001
+
+// Ledger is a stateful in-memory network. Its nodes serve the real CryptoService, TokenService, ConsensusService,
+// FileService and ScheduleService gRPC interfaces on localhost and share one ledger, so SDK transactions and queries
+// work end to end without a network.
+//
+// The ledger tracks hbar and fungible token balances, token associations, topics, files, schedules and receipts.
+// Transactions go through basic prechecks (payer signature, node account, transaction ID, duplicates, valid start)
+// and are handled synchronously, so their receipt is available immediately. Signatures are checked against the keys
+// of the accounts and entities a transaction touches, and a failing check results in an INVALID_SIGNATURE receipt.
+// Fees are not charged and queries cost nothing. Messages submitted to topics are also published on the fake mirror
+// node, with version 3 running hashes.
+//
+// RPCs the ledger doesn't implement fail with codes.Unimplemented.
+type Ledger struct {
+	nodes  []*_LedgerNode
+	mirror *MirrorNode
+	client *hiero.Client
+
+	operatorAccountID hiero.AccountID
+	operatorKey       hiero.PrivateKey
+
+	mutex         sync.Mutex
+	nextEntityNum int64
+	lastTimestamp time.Time
+	accounts      map[int64]*_LedgerAccount
+	tokens        map[int64]*_LedgerToken
+	topics        map[int64]*_LedgerTopic
+	files         map[int64]*_LedgerFile
+	schedules     map[int64]*_LedgerSchedule
+	receipts      map[string]*services.TransactionReceipt
+}
+
+type _LedgerAccount struct {
+	key     *services.Key
+	balance int64
+	memo    string
+	deleted bool
+	tokens  map[int64]int64 // associated token -> balance
+}
+
+type _LedgerNode struct {
+	ledger    *Ledger
+	accountID hiero.AccountID
+	listener  net.Listener
+	server    *grpc.Server
+}
+
+// NewLedger starts the nodes and the mirror node of a new ledger and creates a Client for them. The operator account
+// and the node accounts exist from the start. Close must be called to stop the servers.
+func NewLedger(options ...Option) (*Ledger, error) {
+	c, err := _NewConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := &Ledger{
+		operatorAccountID: c.operatorAccountID,
+		operatorKey:       *c.operatorPrivateKey,
+		nextEntityNum:     _FirstEntityNum,
+		accounts:          make(map[int64]*_LedgerAccount),
+		tokens:            make(map[int64]*_LedgerToken),
+		topics:            make(map[int64]*_LedgerTopic),
+		files:             make(map[int64]*_LedgerFile),
+		schedules:         make(map[int64]*_LedgerSchedule),
+		receipts:          make(map[string]*services.TransactionReceipt),
+	}
+
+	ledger.accounts[int64(c.operatorAccountID.Account)] = &_LedgerAccount{
+		key:     _KeyFromPublicKey(c.operatorPrivateKey.PublicKey()),
+		balance: DefaultOperatorBalance.AsTinybar(),
+		tokens:  make(map[int64]int64),
+	}
+
+	addresses := make(map[string]hiero.AccountID, c.nodeCount)
+	for i := 0; i < c.nodeCount; i++ {
+		accountID := hiero.AccountID{Account: uint64(3 + i)}
+		// Node accounts have an empty key list, which no signatures satisfy, so they can receive hbars but never be debited
+		ledger.accounts[int64(accountID.Account)] = &_LedgerAccount{
+			key:    &services.Key{Key: &services.Key_KeyList{KeyList: &services.KeyList{}}},
+			tokens: make(map[int64]int64),
+		}
+
+		node, err := ledger._NewNode(accountID)
+		if err != nil {
+			ledger.Close()
+			return nil, err
+		}
+
+		ledger.nodes = append(ledger.nodes, node)
+		addresses[node.listener.Addr().String()] = accountID
+	}
+
+	mirrorNode, err := _NewMirrorNode()
+	if err != nil {
+		ledger.Close()
+		return nil, err
+	}
+	ledger.mirror = mirrorNode
+
+	ledger.client = _NewClient(addresses, mirrorNode.Address(), c)
+
+	return ledger, nil
+}
+
+func (ledger *Ledger) _NewNode(accountID hiero.AccountID) (*_LedgerNode, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, err
+	}
+
+	node := &_LedgerNode{
+		ledger:    ledger,
+		accountID: accountID,
+		listener:  listener,
+		server:    grpc.NewServer(),
+	}
+
+	services.RegisterCryptoServiceServer(node.server, &_LedgerCryptoService{node: node})
+	services.RegisterTokenServiceServer(node.server, &_LedgerTokenService{node: node})
+	services.RegisterConsensusServiceServer(node.server, &_LedgerConsensusService{node: node})
+	services.RegisterFileServiceServer(node.server, &_LedgerFileService{node: node})
+	services.RegisterScheduleServiceServer(node.server, &_LedgerScheduleService{node: node})
+
+	go func() {
+		_ = node.server.Serve(listener)
+	}()
+
+	return node, nil
+}
+
+// Client returns a Client connected to the ledger, with the operator and retry backoff set to zero.
+func (ledger *Ledger) Client() *hiero.Client {
+	return ledger.client
+}
+
+// OperatorKey returns the private key of the Client's operator.
+func (ledger *Ledger) OperatorKey() hiero.PrivateKey {
+	return ledger.operatorKey
+}
+
+// NodeAccountIDs returns the account IDs of all consensus nodes, in order.
+func (ledger *Ledger) NodeAccountIDs() []hiero.AccountID {
+	accountIDs := make([]hiero.AccountID, 0, len(ledger.nodes))
+	for _, node := range ledger.nodes {
+		accountIDs = append(accountIDs, node.accountID)
+	}
+
+	return accountIDs
+}
+
+// Mirror returns the fake mirror node receiving the ledger's topic messages.
+func (ledger *Ledger) Mirror() *MirrorNode {
+	return ledger.mirror
+}
+
+// CreateAccount creates an account directly on the ledger, without a transaction. It is meant for test fixtures.
+func (ledger *Ledger) CreateAccount(key hiero.PublicKey, balance hiero.Hbar) hiero.AccountID {
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	num := ledger._NextEntityNum()
+	ledger.accounts[num] = &_LedgerAccount{
+		key:     _KeyFromPublicKey(key),
+		balance: balance.AsTinybar(),
+		tokens:  make(map[int64]int64),
+	}
+
+	return hiero.AccountID{Account: uint64(num)}
+}
+
+// Balance returns the hbar balance of an account, or an error if the account doesn't exist.
+func (ledger *Ledger) Balance(accountID hiero.AccountID) (hiero.Hbar, error) {
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	account, ok := ledger._Account(_AccountIDToProtobuf(accountID))
+	if !ok {
+		return hiero.Hbar{}, fmt.Errorf("hierotest: account %s does not exist", accountID.String())
+	}
+
+	return hiero.HbarFromTinybar(account.balance), nil
+}
+
+// TokenBalance returns the balance of tokenID held by an account, or an error if the account isn't associated with
+// the token.
+func (ledger *Ledger) TokenBalance(accountID hiero.AccountID, tokenID hiero.TokenID) (uint64, error) {
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	account, ok := ledger._Account(_AccountIDToProtobuf(accountID))
+	if !ok {
+		return 0, fmt.Errorf("hierotest: account %s does not exist", accountID.String())
+	}
+
+	balance, ok := account.tokens[int64(tokenID.Token)]
+	if !ok {
+		return 0, fmt.Errorf("hierotest: account %s is not associated with token %s", accountID.String(), tokenID.String())
+	}
+
+	return uint64(balance), nil
+}
+
+// Close closes the Client and stops all servers.
+func (ledger *Ledger) Close() {
+	if ledger.client != nil {
+		_ = ledger.client.Close()
+	}
+
+	for _, node := range ledger.nodes {
+		node.server.Stop()
+	}
+
+	if ledger.mirror != nil {
+		ledger.mirror.close()
+	}
+}
+
+func (ledger *Ledger) _NextEntityNum() int64 {
+	num := ledger.nextEntityNum
+	ledger.nextEntityNum++
+	return num
+}
+
+// _NextTimestamp returns a consensus timestamp after every previous one
+func (ledger *Ledger) _NextTimestamp() time.Time {
+	timestamp := time.Now()
+	if !timestamp.After(ledger.lastTimestamp) {
+		timestamp = ledger.lastTimestamp.Add(time.Nanosecond)
+	}
+
+	ledger.lastTimestamp = timestamp
+	return timestamp
+}
+
+func (ledger *Ledger) _Account(accountID *services.AccountID) (*_LedgerAccount, bool) {
+	if accountID.GetShardNum() != 0 || accountID.GetRealmNum() != 0 {
+		return nil, false
+	}
+
+	account, ok := ledger.accounts[accountID.GetAccountNum()]
+	if !ok || account.deleted {
+		return nil, false
+	}
+
+	return account, true
+}
+
+func _AccountIDToProtobuf(accountID hiero.AccountID) *services.AccountID {
+	return &services.AccountID{
+		ShardNum: int64(accountID.Shard),
+		RealmNum: int64(accountID.Realm),
+		Account:  &services.AccountID_AccountNum{AccountNum: int64(accountID.Account)},
+	}
+}
+
+func _AccountIDFromNum(num int64) *services.AccountID {
+	return &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: num}}
+}
+
+func _TransactionIDKey(transactionID *services.TransactionID) string {
+	accountID := transactionID.GetAccountID()
+	validStart := transactionID.GetTransactionValidStart()
+
+	return fmt.Sprintf("%d.%d.%d@%d.%09d/%t/%d",
+		accountID.GetShardNum(), accountID.GetRealmNum(), accountID.GetAccountNum(),
+		validStart.GetSeconds(), validStart.GetNanos(),
+		transactionID.GetScheduled(), transactionID.GetNonce())
+}
@@ -0,0 +1,211 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"crypto/sha512"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	_MaxTopicMessageBytes = 1024
+	_RunningHashVersion   = hiero.TopicRunningHashVersion
+)
+
+type _LedgerTopic struct {
+	memo             string
+	adminKey         *services.Key
+	submitKey        *services.Key
+	autoRenewPeriod  *services.Duration
+	autoRenewAccount *services.AccountID
+	sequenceNumber   uint64
+	runningHash      []byte
+	deleted          bool
+}
+
+// _LedgerConsensusService serves the ConsensusService of a ledger node
+type _LedgerConsensusService struct {
+	services.UnimplementedConsensusServiceServer
+	node *_LedgerNode
+}
+
+func (service *_LedgerConsensusService) CreateTopic(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.ConsensusService_CreateTopic_FullMethodName, transaction)
+}
+
+func (service *_LedgerConsensusService) DeleteTopic(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.ConsensusService_DeleteTopic_FullMethodName, transaction)
+}
+
+func (service *_LedgerConsensusService) SubmitMessage(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.ConsensusService_SubmitMessage_FullMethodName, transaction)
+}
+
+func (service *_LedgerConsensusService) GetTopicInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	infoQuery := query.GetConsensusGetTopicInfo()
+	header, answer := service.node._QueryHeader(infoQuery.GetHeader())
+	response := &services.ConsensusGetTopicInfoResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		topic, ok := ledger._Topic(infoQuery.GetTopicID())
+		if ok {
+			response.TopicID = infoQuery.GetTopicID()
+			response.TopicInfo = &services.ConsensusTopicInfo{
+				Memo:             topic.memo,
+				RunningHash:      topic.runningHash,
+				SequenceNumber:   topic.sequenceNumber,
+				AdminKey:         topic.adminKey,
+				SubmitKey:        topic.submitKey,
+				AutoRenewPeriod:  topic.autoRenewPeriod,
+				AutoRenewAccount: topic.autoRenewAccount,
+			}
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_TOPIC_ID
+		}
+	}
+
+	return &services.Response{Response: &services.Response_ConsensusGetTopicInfo{ConsensusGetTopicInfo: response}}, nil
+}
+
+func (ledger *Ledger) _HandleTopicCreate(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetConsensusCreateTopic()
+	if len(body.Memo) > _MaxMemoBytes {
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	}
+
+	if body.AutoRenewAccount != nil {
+		account, ok := ledger._Account(body.AutoRenewAccount)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_AUTORENEW_ACCOUNT
+		}
+		if !tx.signers._Satisfies(account.key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+	}
+
+	if body.AdminKey != nil && !tx.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	num := ledger._NextEntityNum()
+	ledger.topics[num] = &_LedgerTopic{
+		memo:             body.Memo,
+		adminKey:         body.AdminKey,
+		submitKey:        body.SubmitKey,
+		autoRenewPeriod:  body.AutoRenewPeriod,
+		autoRenewAccount: body.AutoRenewAccount,
+		runningHash:      make([]byte, sha512.Size384),
+	}
+
+	receipt.TopicID = &services.TopicID{TopicNum: num}
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleTopicDelete(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetConsensusDeleteTopic()
+
+	topic, ok := ledger._Topic(body.TopicID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TOPIC_ID
+	}
+	if topic.adminKey == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx.signers._Satisfies(topic.adminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	topic.deleted = true
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleTopicSubmitMessage(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetConsensusSubmitMessage()
+
+	topic, ok := ledger._Topic(body.TopicID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TOPIC_ID
+	}
+	if topic.submitKey != nil && !tx.signers._Satisfies(topic.submitKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if len(body.Message) == 0 {
+		return services.ResponseCodeEnum_INVALID_TOPIC_MESSAGE
+	}
+	if len(body.Message) > _MaxTopicMessageBytes {
+		return services.ResponseCodeEnum_MESSAGE_SIZE_TOO_LARGE
+	}
+
+	if chunkInfo := body.ChunkInfo; chunkInfo != nil {
+		if chunkInfo.Number < 1 || chunkInfo.Number > chunkInfo.Total {
+			return services.ResponseCodeEnum_INVALID_CHUNK_NUMBER
+		}
+		if chunkInfo.Number == 1 && !protobuf.Equal(chunkInfo.InitialTransactionID, tx.body.TransactionID) {
+			return services.ResponseCodeEnum_INVALID_CHUNK_TRANSACTION_ID
+		}
+	}
+
+	topic.sequenceNumber++
+	topic.runningHash = _RunningHash(topic.runningHash, tx.payer, body.TopicID, tx.timestamp, topic.sequenceNumber, body.Message)
+
+	receipt.TopicSequenceNumber = topic.sequenceNumber
+	receipt.TopicRunningHash = topic.runningHash
+	receipt.TopicRunningHashVersion = _RunningHashVersion
+
+	ledger.mirror.AddTopicMessages(
+		hiero.TopicID{Shard: uint64(body.TopicID.ShardNum), Realm: uint64(body.TopicID.RealmNum), Topic: uint64(body.TopicID.TopicNum)},
+		&mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(tx.timestamp),
+			Message:            body.Message,
+			RunningHash:        topic.runningHash,
+			SequenceNumber:     topic.sequenceNumber,
+			RunningHashVersion: _RunningHashVersion,
+			ChunkInfo:          body.ChunkInfo,
+		},
+	)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _RunningHash computes the version 3 running hash of a topic after a message was added to it
+func _RunningHash(
+	previous []byte,
+	payer *services.AccountID,
+	topicID *services.TopicID,
+	timestamp time.Time,
+	sequenceNumber uint64,
+	message []byte,
+) []byte {
+	return hiero.ComputeTopicRunningHash(
+		previous,
+		hiero.AccountID{Shard: uint64(payer.GetShardNum()), Realm: uint64(payer.GetRealmNum()), Account: uint64(payer.GetAccountNum())},
+		hiero.TopicID{Shard: uint64(topicID.GetShardNum()), Realm: uint64(topicID.GetRealmNum()), Topic: uint64(topicID.GetTopicNum())},
+		timestamp,
+		sequenceNumber,
+		message,
+	)
+}
+
+func (ledger *Ledger) _Topic(topicID *services.TopicID) (*_LedgerTopic, bool) {
+	if topicID.GetShardNum() != 0 || topicID.GetRealmNum() != 0 {
+		return nil, false
+	}
+
+	topic, ok := ledger.topics[topicID.GetTopicNum()]
+	if !ok || topic.deleted {
+		return nil, false
+	}
+
+	return topic, true
+}
@@ -0,0 +1,299 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// _LedgerCryptoService serves the CryptoService of a ledger node
+type _LedgerCryptoService struct {
+	services.UnimplementedCryptoServiceServer
+	node *_LedgerNode
+}
+
+func (service *_LedgerCryptoService) CreateAccount(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.CryptoService_CreateAccount_FullMethodName, transaction)
+}
+
+func (service *_LedgerCryptoService) CryptoTransfer(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.CryptoService_CryptoTransfer_FullMethodName, transaction)
+}
+
+func (service *_LedgerCryptoService) CryptoDelete(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.CryptoService_CryptoDelete_FullMethodName, transaction)
+}
+
+func (service *_LedgerCryptoService) CryptoGetBalance(_ context.Context, query *services.Query) (*services.Response, error) {
+	balanceQuery := query.GetCryptogetAccountBalance()
+	header, answer := service.node._QueryHeader(balanceQuery.GetHeader())
+	response := &services.CryptoGetAccountBalanceResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		account, ok := ledger._Account(balanceQuery.GetAccountID())
+		if ok {
+			response.AccountID = balanceQuery.GetAccountID()
+			response.Balance = uint64(account.balance)
+			response.TokenBalances = ledger._TokenBalances(account) // nolint
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+		}
+	}
+
+	return &services.Response{Response: &services.Response_CryptogetAccountBalance{CryptogetAccountBalance: response}}, nil
+}
+
+func (service *_LedgerCryptoService) GetAccountInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	infoQuery := query.GetCryptoGetInfo()
+	header, answer := service.node._QueryHeader(infoQuery.GetHeader())
+	response := &services.CryptoGetInfoResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		account, ok := ledger._Account(infoQuery.GetAccountID())
+		if ok {
+			response.AccountInfo = &services.CryptoGetInfoResponse_AccountInfo{
+				AccountID:          infoQuery.GetAccountID(),
+				Key:                account.key,
+				Balance:            uint64(account.balance),
+				Memo:               account.memo,
+				ExpirationTime:     _TimeToProtobuf(time.Unix(0, 0)),
+				AutoRenewPeriod:    &services.Duration{},
+				TokenRelationships: ledger._TokenRelationships(account), // nolint
+			}
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+		}
+	}
+
+	return &services.Response{Response: &services.Response_CryptoGetInfo{CryptoGetInfo: response}}, nil
+}
+
+func (service *_LedgerCryptoService) GetTransactionReceipts(_ context.Context, query *services.Query) (*services.Response, error) {
+	receiptQuery := query.GetTransactionGetReceipt()
+	header, answer := service.node._QueryHeader(receiptQuery.GetHeader())
+	response := &services.TransactionGetReceiptResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		if receipt, ok := ledger.receipts[_TransactionIDKey(receiptQuery.GetTransactionID())]; ok {
+			response.Receipt = receipt
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_RECEIPT_NOT_FOUND
+		}
+	}
+
+	return &services.Response{Response: &services.Response_TransactionGetReceipt{TransactionGetReceipt: response}}, nil
+}
+
+func (ledger *Ledger) _HandleCryptoCreate(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetCryptoCreateAccount()
+	if status := _ValidateKey(body.GetKey()); status != services.ResponseCodeEnum_OK {
+		return status
+	}
+	if len(body.Memo) > _MaxMemoBytes {
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	}
+
+	payer, _ := ledger._Account(tx.payer)
+	if int64(body.InitialBalance) > payer.balance {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+
+	if body.ReceiverSigRequired && !tx.signers._Satisfies(body.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	num := ledger._NextEntityNum()
+	ledger.accounts[num] = &_LedgerAccount{
+		key:     body.Key,
+		balance: int64(body.InitialBalance),
+		memo:    body.Memo,
+		tokens:  make(map[int64]int64),
+	}
+	payer.balance -= int64(body.InitialBalance)
+
+	receipt.AccountID = _AccountIDFromNum(num)
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleCryptoTransfer(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetCryptoTransfer()
+
+	hbarChanges := make(map[int64]int64)
+	var sum int64
+	for _, transfer := range body.GetTransfers().GetAccountAmounts() {
+		if transfer.IsApproval {
+			return services.ResponseCodeEnum_NOT_SUPPORTED
+		}
+
+		account, ok := ledger._Account(transfer.AccountID)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+		}
+
+		num := transfer.AccountID.GetAccountNum()
+		if _, ok := hbarChanges[num]; ok {
+			return services.ResponseCodeEnum_ACCOUNT_REPEATED_IN_ACCOUNT_AMOUNTS
+		}
+		if transfer.Amount < 0 && !tx.signers._Satisfies(account.key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+
+		hbarChanges[num] = transfer.Amount
+		sum += transfer.Amount
+	}
+	if sum != 0 {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_AMOUNTS
+	}
+
+	tokenChanges := make(map[int64]map[int64]int64)
+	for _, tokenTransfers := range body.GetTokenTransfers() {
+		if len(tokenTransfers.GetNftTransfers()) > 0 {
+			return services.ResponseCodeEnum_NOT_SUPPORTED
+		}
+
+		tokenNum := tokenTransfers.GetToken().GetTokenNum()
+		token, ok := ledger._Token(tokenTransfers.GetToken())
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+		if _, ok := tokenChanges[tokenNum]; ok {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+		if expected := tokenTransfers.GetExpectedDecimals(); expected != nil && expected.Value != token.decimals {
+			return services.ResponseCodeEnum_UNEXPECTED_TOKEN_DECIMALS
+		}
+
+		changes := make(map[int64]int64)
+		sum = 0
+		for _, transfer := range tokenTransfers.GetTransfers() {
+			if transfer.IsApproval {
+				return services.ResponseCodeEnum_NOT_SUPPORTED
+			}
+
+			account, ok := ledger._Account(transfer.AccountID)
+			if !ok {
+				return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+			}
+
+			num := transfer.AccountID.GetAccountNum()
+			if _, ok := changes[num]; ok {
+				return services.ResponseCodeEnum_ACCOUNT_REPEATED_IN_ACCOUNT_AMOUNTS
+			}
+			balance, ok := account.tokens[tokenNum]
+			if !ok {
+				return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+			}
+			if transfer.Amount < 0 && !tx.signers._Satisfies(account.key) {
+				return services.ResponseCodeEnum_INVALID_SIGNATURE
+			}
+			if balance+transfer.Amount < 0 {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+
+			changes[num] = transfer.Amount
+			sum += transfer.Amount
+		}
+		if sum != 0 {
+			return services.ResponseCodeEnum_TRANSFERS_NOT_ZERO_SUM_FOR_TOKEN
+		}
+
+		tokenChanges[tokenNum] = changes
+	}
+
+	if len(hbarChanges) == 0 && len(tokenChanges) == 0 {
+		return services.ResponseCodeEnum_EMPTY_TOKEN_TRANSFER_BODY
+	}
+
+	for num, amount := range hbarChanges {
+		if ledger.accounts[num].balance+amount < 0 {
+			return services.ResponseCodeEnum_INSUFFICIENT_ACCOUNT_BALANCE
+		}
+	}
+
+	for num, amount := range hbarChanges {
+		ledger.accounts[num].balance += amount
+	}
+	for tokenNum, changes := range tokenChanges {
+		for num, amount := range changes {
+			ledger.accounts[num].tokens[tokenNum] += amount
+		}
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleCryptoDelete(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetCryptoDelete()
+
+	account, ok := ledger._Account(body.GetDeleteAccountID())
+	if !ok {
+		return services.ResponseCodeEnum_ACCOUNT_ID_DOES_NOT_EXIST
+	}
+
+	transferAccount, ok := ledger._Account(body.GetTransferAccountID())
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TRANSFER_ACCOUNT_ID
+	}
+	if account == transferAccount {
+		return services.ResponseCodeEnum_TRANSFER_ACCOUNT_SAME_AS_DELETE_ACCOUNT
+	}
+
+	if !tx.signers._Satisfies(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	for _, balance := range account.tokens {
+		if balance != 0 {
+			return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+		}
+	}
+
+	transferAccount.balance += account.balance
+	account.balance = 0
+	account.deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _TokenBalances(account *_LedgerAccount) []*services.TokenBalance {
+	balances := make([]*services.TokenBalance, 0, len(account.tokens))
+	for tokenNum, balance := range account.tokens {
+		balances = append(balances, &services.TokenBalance{
+			TokenId:  _TokenIDFromNum(tokenNum),
+			Balance:  uint64(balance),
+			Decimals: ledger.tokens[tokenNum].decimals,
+		})
+	}
+
+	return balances
+}
+
+func (ledger *Ledger) _TokenRelationships(account *_LedgerAccount) []*services.TokenRelationship {
+	relationships := make([]*services.TokenRelationship, 0, len(account.tokens))
+	for tokenNum, balance := range account.tokens {
+		token := ledger.tokens[tokenNum]
+		relationships = append(relationships, &services.TokenRelationship{
+			TokenId:  _TokenIDFromNum(tokenNum),
+			Symbol:   token.symbol,
+			Balance:  uint64(balance),
+			Decimals: token.decimals,
+		})
+	}
+
+	return relationships
+}
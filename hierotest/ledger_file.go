@@ -0,0 +1,199 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+type _LedgerFile struct {
+	keys           *services.KeyList
+	contents       []byte
+	memo           string
+	expirationTime *services.Timestamp
+	deleted        bool
+}
+
+// _LedgerFileService serves the FileService of a ledger node
+type _LedgerFileService struct {
+	services.UnimplementedFileServiceServer
+	node *_LedgerNode
+}
+
+func (service *_LedgerFileService) CreateFile(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.FileService_CreateFile_FullMethodName, transaction)
+}
+
+func (service *_LedgerFileService) AppendContent(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.FileService_AppendContent_FullMethodName, transaction)
+}
+
+func (service *_LedgerFileService) UpdateFile(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.FileService_UpdateFile_FullMethodName, transaction)
+}
+
+func (service *_LedgerFileService) DeleteFile(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.FileService_DeleteFile_FullMethodName, transaction)
+}
+
+func (service *_LedgerFileService) GetFileContent(_ context.Context, query *services.Query) (*services.Response, error) {
+	contentsQuery := query.GetFileGetContents()
+	header, answer := service.node._QueryHeader(contentsQuery.GetHeader())
+	response := &services.FileGetContentsResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		file, status := ledger._File(contentsQuery.GetFileID())
+		if status == services.ResponseCodeEnum_OK {
+			response.FileContents = &services.FileGetContentsResponse_FileContents{
+				FileID:   contentsQuery.GetFileID(),
+				Contents: file.contents,
+			}
+		} else {
+			header.NodeTransactionPrecheckCode = status
+		}
+	}
+
+	return &services.Response{Response: &services.Response_FileGetContents{FileGetContents: response}}, nil
+}
+
+func (service *_LedgerFileService) GetFileInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	infoQuery := query.GetFileGetInfo()
+	header, answer := service.node._QueryHeader(infoQuery.GetHeader())
+	response := &services.FileGetInfoResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		file, ok := ledger.files[infoQuery.GetFileID().GetFileNum()]
+		if ok && infoQuery.GetFileID().GetShardNum() == 0 && infoQuery.GetFileID().GetRealmNum() == 0 {
+			response.FileInfo = &services.FileGetInfoResponse_FileInfo{
+				FileID:         infoQuery.GetFileID(),
+				Size:           int64(len(file.contents)),
+				ExpirationTime: file.expirationTime,
+				Deleted:        file.deleted,
+				Keys:           file.keys,
+				Memo:           file.memo,
+			}
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_FILE_ID
+		}
+	}
+
+	return &services.Response{Response: &services.Response_FileGetInfo{FileGetInfo: response}}, nil
+}
+
+func (ledger *Ledger) _HandleFileCreate(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetFileCreate()
+	if len(body.Memo) > _MaxMemoBytes {
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	}
+	if !tx.signers._SatisfiesAll(body.GetKeys().GetKeys()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	num := ledger._NextEntityNum()
+	ledger.files[num] = &_LedgerFile{
+		keys:           body.Keys,
+		contents:       body.Contents,
+		memo:           body.Memo,
+		expirationTime: body.ExpirationTime,
+	}
+
+	receipt.FileID = &services.FileID{FileNum: num}
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleFileAppend(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetFileAppend()
+
+	file, status := ledger._MutableFile(tx, body.GetFileID())
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+
+	file.contents = append(append([]byte(nil), file.contents...), body.Contents...)
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleFileUpdate(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetFileUpdate()
+
+	file, status := ledger._MutableFile(tx, body.GetFileID())
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+	if body.Memo != nil && len(body.Memo.Value) > _MaxMemoBytes {
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	}
+	if body.Keys != nil && !tx.signers._SatisfiesAll(body.Keys.GetKeys()) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.Keys != nil {
+		file.keys = body.Keys
+	}
+	if body.Contents != nil {
+		file.contents = body.Contents
+	}
+	if body.Memo != nil {
+		file.memo = body.Memo.Value
+	}
+	if body.ExpirationTime != nil {
+		file.expirationTime = body.ExpirationTime
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleFileDelete(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetFileDelete()
+
+	file, status := ledger._MutableFile(tx, body.GetFileID())
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+
+	file.contents = nil
+	file.deleted = true
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _MutableFile returns a file that a transaction may change: it must exist, have keys, and all of them must have signed
+func (ledger *Ledger) _MutableFile(tx *_LedgerTransaction, fileID *services.FileID) (*_LedgerFile, services.ResponseCodeEnum) {
+	file, status := ledger._File(fileID)
+	if status != services.ResponseCodeEnum_OK {
+		return nil, status
+	}
+	if len(file.keys.GetKeys()) == 0 {
+		return nil, services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !tx.signers._SatisfiesAll(file.keys.GetKeys()) {
+		return nil, services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return file, services.ResponseCodeEnum_OK
+}
+
+func (ledger *Ledger) _File(fileID *services.FileID) (*_LedgerFile, services.ResponseCodeEnum) {
+	if fileID.GetShardNum() != 0 || fileID.GetRealmNum() != 0 {
+		return nil, services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+
+	file, ok := ledger.files[fileID.GetFileNum()]
+	if !ok {
+		return nil, services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+	if file.deleted {
+		return nil, services.ResponseCodeEnum_FILE_DELETED
+	}
+
+	return file, services.ResponseCodeEnum_OK
+}
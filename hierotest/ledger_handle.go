@@ -0,0 +1,264 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	_MaxMemoBytes          = 100
+	_MinValidDuration      = 15 * time.Second
+	_MaxValidDuration      = 180 * time.Second
+	_DefaultValidDuration  = 120 * time.Second
+	_ValidStartClockSkew   = 10 * time.Second
+	_ExchangeRateCentEquiv = 12
+)
+
+// _LedgerTransaction is a transaction that passed the prechecks and is being handled
+type _LedgerTransaction struct {
+	body      *services.TransactionBody
+	payer     *services.AccountID
+	signers   _Signers
+	timestamp time.Time
+}
+
+// _LedgerHandler applies a transaction to the ledger and returns the status of its receipt. Handlers must validate
+// everything, including signatures, before changing any state, so a failed transaction leaves the ledger untouched.
+type _LedgerHandler func(ledger *Ledger, tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum
+
+// _LedgerHandlerFor returns the RPC a transaction body must be sent to and the handler applying it, or a nil handler
+// if the ledger doesn't support the transaction
+func _LedgerHandlerFor(body *services.TransactionBody) (string, _LedgerHandler) {
+	switch body.GetData().(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		return services.CryptoService_CreateAccount_FullMethodName, (*Ledger)._HandleCryptoCreate
+	case *services.TransactionBody_CryptoTransfer:
+		return services.CryptoService_CryptoTransfer_FullMethodName, (*Ledger)._HandleCryptoTransfer
+	case *services.TransactionBody_CryptoDelete:
+		return services.CryptoService_CryptoDelete_FullMethodName, (*Ledger)._HandleCryptoDelete
+	case *services.TransactionBody_TokenCreation:
+		return services.TokenService_CreateToken_FullMethodName, (*Ledger)._HandleTokenCreate
+	case *services.TransactionBody_TokenAssociate:
+		return services.TokenService_AssociateTokens_FullMethodName, (*Ledger)._HandleTokenAssociate
+	case *services.TransactionBody_TokenDissociate:
+		return services.TokenService_DissociateTokens_FullMethodName, (*Ledger)._HandleTokenDissociate
+	case *services.TransactionBody_TokenMint:
+		return services.TokenService_MintToken_FullMethodName, (*Ledger)._HandleTokenMint
+	case *services.TransactionBody_TokenBurn:
+		return services.TokenService_BurnToken_FullMethodName, (*Ledger)._HandleTokenBurn
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return services.ConsensusService_CreateTopic_FullMethodName, (*Ledger)._HandleTopicCreate
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return services.ConsensusService_DeleteTopic_FullMethodName, (*Ledger)._HandleTopicDelete
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return services.ConsensusService_SubmitMessage_FullMethodName, (*Ledger)._HandleTopicSubmitMessage
+	case *services.TransactionBody_FileCreate:
+		return services.FileService_CreateFile_FullMethodName, (*Ledger)._HandleFileCreate
+	case *services.TransactionBody_FileAppend:
+		return services.FileService_AppendContent_FullMethodName, (*Ledger)._HandleFileAppend
+	case *services.TransactionBody_FileUpdate:
+		return services.FileService_UpdateFile_FullMethodName, (*Ledger)._HandleFileUpdate
+	case *services.TransactionBody_FileDelete:
+		return services.FileService_DeleteFile_FullMethodName, (*Ledger)._HandleFileDelete
+	case *services.TransactionBody_ScheduleCreate:
+		return services.ScheduleService_CreateSchedule_FullMethodName, (*Ledger)._HandleScheduleCreate
+	case *services.TransactionBody_ScheduleSign:
+		return services.ScheduleService_SignSchedule_FullMethodName, (*Ledger)._HandleScheduleSign
+	case *services.TransactionBody_ScheduleDelete:
+		return services.ScheduleService_DeleteSchedule_FullMethodName, (*Ledger)._HandleScheduleDelete
+	}
+
+	return "", nil
+}
+
+// _Submit prechecks a transaction sent to method and, if it passes, handles it and stores its receipt
+func (node *_LedgerNode) _Submit(method string, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	ledger := node.ledger
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	return &services.TransactionResponse{
+		NodeTransactionPrecheckCode: node._SubmitLocked(method, transaction),
+	}, nil
+}
+
+func (node *_LedgerNode) _SubmitLocked(method string, transaction *services.Transaction) services.ResponseCodeEnum {
+	ledger := node.ledger
+
+	bodyBytes, sigMap, ok := _DecodeTransaction(transaction)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+
+	expectedMethod, handler := _LedgerHandlerFor(&body)
+	if handler == nil {
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+	if expectedMethod != method {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+
+	if status := node._PrecheckBody(&body); status != services.ResponseCodeEnum_OK {
+		return status
+	}
+
+	payer := body.TransactionID.AccountID
+	if _, ok := ledger.receipts[_TransactionIDKey(body.TransactionID)]; ok {
+		return services.ResponseCodeEnum_DUPLICATE_TRANSACTION
+	}
+
+	payerAccount, ok := ledger._Account(payer)
+	if !ok {
+		return services.ResponseCodeEnum_PAYER_ACCOUNT_NOT_FOUND
+	}
+
+	signers := _VerifySignatures(bodyBytes, sigMap)
+	if !signers._Satisfies(payerAccount.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	tx := &_LedgerTransaction{
+		body:      &body,
+		payer:     payer,
+		signers:   signers,
+		timestamp: ledger._NextTimestamp(),
+	}
+
+	receipt := ledger._NewReceipt(tx.timestamp)
+	receipt.Status = handler(ledger, tx, receipt)
+	ledger.receipts[_TransactionIDKey(body.TransactionID)] = receipt
+
+	return services.ResponseCodeEnum_OK
+}
+
+func (node *_LedgerNode) _PrecheckBody(body *services.TransactionBody) services.ResponseCodeEnum {
+	transactionID := body.GetTransactionID()
+	if transactionID.GetAccountID() == nil || transactionID.GetTransactionValidStart() == nil || transactionID.GetScheduled() {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_ID
+	}
+
+	nodeAccountID := body.GetNodeAccountID()
+	if nodeAccountID.GetShardNum() != int64(node.accountID.Shard) ||
+		nodeAccountID.GetRealmNum() != int64(node.accountID.Realm) ||
+		nodeAccountID.GetAccountNum() != int64(node.accountID.Account) {
+		return services.ResponseCodeEnum_INVALID_NODE_ACCOUNT
+	}
+
+	validDuration := _DefaultValidDuration
+	if body.TransactionValidDuration != nil {
+		validDuration = time.Duration(body.TransactionValidDuration.Seconds) * time.Second
+	}
+	if validDuration < _MinValidDuration || validDuration > _MaxValidDuration {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_DURATION
+	}
+
+	now := time.Now()
+	validStart := _TimeFromProtobuf(transactionID.TransactionValidStart)
+	if validStart.After(now.Add(_ValidStartClockSkew)) {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_START
+	}
+	if validStart.Add(validDuration).Before(now) {
+		return services.ResponseCodeEnum_TRANSACTION_EXPIRED
+	}
+
+	if len(body.Memo) > _MaxMemoBytes {
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	}
+
+	return services.ResponseCodeEnum_OK
+}
+
+// _DecodeTransaction returns the body bytes and signatures of a transaction in either of its wire formats
+func _DecodeTransaction(transaction *services.Transaction) ([]byte, *services.SignatureMap, bool) {
+	if len(transaction.GetSignedTransactionBytes()) > 0 {
+		var signed services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, &signed); err != nil {
+			return nil, nil, false
+		}
+
+		return signed.GetBodyBytes(), signed.GetSigMap(), true
+	}
+
+	if len(transaction.GetBodyBytes()) > 0 {
+		return transaction.BodyBytes, transaction.GetSigMap(), true
+	}
+
+	return nil, nil, false
+}
+
+func (ledger *Ledger) _NewReceipt(timestamp time.Time) *services.TransactionReceipt {
+	expiration := &services.TimestampSeconds{Seconds: timestamp.Add(time.Hour).Unix()}
+
+	return &services.TransactionReceipt{
+		ExchangeRate: &services.ExchangeRateSet{
+			CurrentRate: &services.ExchangeRate{HbarEquiv: 1, CentEquiv: _ExchangeRateCentEquiv, ExpirationTime: expiration},
+			NextRate:    &services.ExchangeRate{HbarEquiv: 1, CentEquiv: _ExchangeRateCentEquiv, ExpirationTime: expiration},
+		},
+	}
+}
+
+// _QueryHeader builds the response header for a query and reports whether the query must be answered. Cost queries
+// are never answered since every query is free. The payment of a paid query, if any, must be signed by its payer.
+func (node *_LedgerNode) _QueryHeader(header *services.QueryHeader) (*services.ResponseHeader, bool) {
+	responseHeader := &services.ResponseHeader{
+		NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		ResponseType:                header.GetResponseType(),
+	}
+
+	if header.GetResponseType() == services.ResponseType_COST_ANSWER || header.GetResponseType() == services.ResponseType_COST_ANSWER_STATE_PROOF {
+		return responseHeader, false
+	}
+
+	if payment := header.GetPayment(); payment != nil {
+		if status := node._PrecheckPayment(payment); status != services.ResponseCodeEnum_OK {
+			responseHeader.NodeTransactionPrecheckCode = status
+			return responseHeader, false
+		}
+	}
+
+	return responseHeader, true
+}
+
+func (node *_LedgerNode) _PrecheckPayment(payment *services.Transaction) services.ResponseCodeEnum {
+	ledger := node.ledger
+	ledger.mutex.Lock()
+	defer ledger.mutex.Unlock()
+
+	bodyBytes, sigMap, ok := _DecodeTransaction(payment)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+
+	if body.GetCryptoTransfer() == nil {
+		return services.ResponseCodeEnum_INSUFFICIENT_TX_FEE
+	}
+
+	if status := node._PrecheckBody(&body); status != services.ResponseCodeEnum_OK {
+		return status
+	}
+
+	payerAccount, ok := ledger._Account(body.TransactionID.AccountID)
+	if !ok {
+		return services.ResponseCodeEnum_PAYER_ACCOUNT_NOT_FOUND
+	}
+
+	if !_VerifySignatures(bodyBytes, sigMap)._Satisfies(payerAccount.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return services.ResponseCodeEnum_OK
+}
@@ -0,0 +1,274 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type _LedgerSchedule struct {
+	schedulable    *services.SchedulableTransactionBody
+	body           *services.TransactionBody
+	memo           string
+	adminKey       *services.Key
+	creator        *services.AccountID
+	payer          *services.AccountID
+	expirationTime *services.Timestamp
+	signers        _Signers
+	executedAt     *time.Time
+	deletedAt      *time.Time
+}
+
+// _LedgerScheduleService serves the ScheduleService of a ledger node. A schedule executes as soon as the signatures
+// collected by its ScheduleCreate and ScheduleSign transactions satisfy the keys its transaction requires; schedules
+// never expire.
+type _LedgerScheduleService struct {
+	services.UnimplementedScheduleServiceServer
+	node *_LedgerNode
+}
+
+func (service *_LedgerScheduleService) CreateSchedule(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.ScheduleService_CreateSchedule_FullMethodName, transaction)
+}
+
+func (service *_LedgerScheduleService) SignSchedule(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.ScheduleService_SignSchedule_FullMethodName, transaction)
+}
+
+func (service *_LedgerScheduleService) DeleteSchedule(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.ScheduleService_DeleteSchedule_FullMethodName, transaction)
+}
+
+func (service *_LedgerScheduleService) GetScheduleInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	infoQuery := query.GetScheduleGetInfo()
+	header, answer := service.node._QueryHeader(infoQuery.GetHeader())
+	response := &services.ScheduleGetInfoResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		schedule, ok := ledger._Schedule(infoQuery.GetScheduleID())
+		if ok {
+			info := &services.ScheduleInfo{
+				ScheduleID:               infoQuery.GetScheduleID(),
+				ExpirationTime:           schedule.expirationTime,
+				ScheduledTransactionBody: schedule.schedulable,
+				Memo:                     schedule.memo,
+				AdminKey:                 schedule.adminKey,
+				Signers:                  schedule.signers._KeyList(),
+				CreatorAccountID:         schedule.creator,
+				PayerAccountID:           schedule.payer,
+				ScheduledTransactionID:   schedule.body.TransactionID,
+			}
+			if schedule.executedAt != nil {
+				info.Data = &services.ScheduleInfo_ExecutionTime{ExecutionTime: _TimeToProtobuf(*schedule.executedAt)}
+			} else if schedule.deletedAt != nil {
+				info.Data = &services.ScheduleInfo_DeletionTime{DeletionTime: _TimeToProtobuf(*schedule.deletedAt)}
+			}
+			response.ScheduleInfo = info
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_SCHEDULE_ID
+		}
+	}
+
+	return &services.Response{Response: &services.Response_ScheduleGetInfo{ScheduleGetInfo: response}}, nil
+}
+
+func (ledger *Ledger) _HandleScheduleCreate(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetScheduleCreate()
+	if len(body.Memo) > _MaxMemoBytes {
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	}
+
+	scheduledTransactionID := protobuf.Clone(tx.body.TransactionID).(*services.TransactionID)
+	scheduledTransactionID.Scheduled = true
+
+	scheduledBody, ok := _ScheduledTransactionBody(body.ScheduledTransactionBody, scheduledTransactionID)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+	if _, handler := _LedgerHandlerFor(scheduledBody); handler == nil || _IsScheduleTransaction(scheduledBody) {
+		return services.ResponseCodeEnum_SCHEDULED_TRANSACTION_NOT_IN_WHITELIST
+	}
+
+	payer := tx.payer
+	if body.PayerAccountID != nil {
+		payer = body.PayerAccountID
+	}
+	if _, ok := ledger._Account(payer); !ok {
+		return services.ResponseCodeEnum_INVALID_SCHEDULE_PAYER_ID
+	}
+
+	if body.AdminKey != nil && !tx.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	for num, schedule := range ledger.schedules {
+		if schedule.executedAt == nil && schedule.deletedAt == nil &&
+			protobuf.Equal(schedule.schedulable, body.ScheduledTransactionBody) &&
+			protobuf.Equal(schedule.payer, payer) &&
+			protobuf.Equal(schedule.adminKey, body.AdminKey) &&
+			schedule.memo == body.Memo {
+			receipt.ScheduleID = &services.ScheduleID{ScheduleNum: num}
+			receipt.ScheduledTransactionID = schedule.body.TransactionID
+			return services.ResponseCodeEnum_IDENTICAL_SCHEDULE_ALREADY_CREATED
+		}
+	}
+
+	schedule := &_LedgerSchedule{
+		schedulable:    body.ScheduledTransactionBody,
+		body:           scheduledBody,
+		memo:           body.Memo,
+		adminKey:       body.AdminKey,
+		creator:        tx.payer,
+		payer:          payer,
+		expirationTime: body.ExpirationTime,
+		signers:        make(_Signers),
+	}
+	schedule.signers._Add(tx.signers)
+
+	num := ledger._NextEntityNum()
+	ledger.schedules[num] = schedule
+	ledger._TryExecuteSchedule(schedule, tx.timestamp)
+
+	receipt.ScheduleID = &services.ScheduleID{ScheduleNum: num}
+	receipt.ScheduledTransactionID = scheduledTransactionID
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleScheduleSign(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetScheduleSign()
+
+	schedule, status := ledger._PendingSchedule(body.ScheduleID)
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+
+	newSigners := 0
+	for key := range tx.signers {
+		if !schedule.signers[key] {
+			newSigners++
+		}
+	}
+	if newSigners == 0 {
+		return services.ResponseCodeEnum_NO_NEW_VALID_SIGNATURES
+	}
+
+	schedule.signers._Add(tx.signers)
+	ledger._TryExecuteSchedule(schedule, tx.timestamp)
+
+	receipt.ScheduledTransactionID = schedule.body.TransactionID
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleScheduleDelete(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetScheduleDelete()
+
+	schedule, status := ledger._PendingSchedule(body.ScheduleID)
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+	if schedule.adminKey == nil {
+		return services.ResponseCodeEnum_SCHEDULE_IS_IMMUTABLE
+	}
+	if !tx.signers._Satisfies(schedule.adminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	deletedAt := tx.timestamp
+	schedule.deletedAt = &deletedAt
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TryExecuteSchedule executes a schedule if its signers satisfy the keys of its payer and of its transaction. The
+// receipt of the scheduled transaction is stored under its scheduled transaction ID.
+func (ledger *Ledger) _TryExecuteSchedule(schedule *_LedgerSchedule, timestamp time.Time) {
+	payer, ok := ledger._Account(schedule.payer)
+	if !ok || !schedule.signers._Satisfies(payer.key) {
+		return
+	}
+
+	_, handler := _LedgerHandlerFor(schedule.body)
+	tx := &_LedgerTransaction{
+		body:      schedule.body,
+		payer:     schedule.payer,
+		signers:   schedule.signers,
+		timestamp: timestamp,
+	}
+
+	receipt := ledger._NewReceipt(timestamp)
+	receipt.Status = handler(ledger, tx, receipt)
+	if receipt.Status == services.ResponseCodeEnum_INVALID_SIGNATURE {
+		return
+	}
+
+	schedule.executedAt = &timestamp
+	ledger.receipts[_TransactionIDKey(schedule.body.TransactionID)] = receipt
+}
+
+func (ledger *Ledger) _PendingSchedule(scheduleID *services.ScheduleID) (*_LedgerSchedule, services.ResponseCodeEnum) {
+	schedule, ok := ledger._Schedule(scheduleID)
+	switch {
+	case !ok:
+		return nil, services.ResponseCodeEnum_INVALID_SCHEDULE_ID
+	case schedule.deletedAt != nil:
+		return nil, services.ResponseCodeEnum_SCHEDULE_ALREADY_DELETED
+	case schedule.executedAt != nil:
+		return nil, services.ResponseCodeEnum_SCHEDULE_ALREADY_EXECUTED
+	}
+
+	return schedule, services.ResponseCodeEnum_OK
+}
+
+func (ledger *Ledger) _Schedule(scheduleID *services.ScheduleID) (*_LedgerSchedule, bool) {
+	if scheduleID.GetShardNum() != 0 || scheduleID.GetRealmNum() != 0 {
+		return nil, false
+	}
+
+	schedule, ok := ledger.schedules[scheduleID.GetScheduleNum()]
+	return schedule, ok
+}
+
+// _ScheduledTransactionBody converts the body of a scheduled transaction to a regular transaction body. Both messages
+// name the fields of their data oneof the same way, so the set field is copied over by name.
+func _ScheduledTransactionBody(schedulable *services.SchedulableTransactionBody, transactionID *services.TransactionID) (*services.TransactionBody, bool) {
+	if schedulable == nil {
+		return nil, false
+	}
+
+	source := schedulable.ProtoReflect()
+	field := source.WhichOneof(source.Descriptor().Oneofs().ByName("data"))
+	if field == nil {
+		return nil, false
+	}
+
+	body := &services.TransactionBody{
+		TransactionID:  transactionID,
+		TransactionFee: schedulable.TransactionFee,
+		Memo:           schedulable.Memo,
+	}
+
+	target := body.ProtoReflect()
+	targetField := target.Descriptor().Fields().ByName(field.Name())
+	if targetField == nil || targetField.Message() == nil || targetField.Message().FullName() != field.Message().FullName() {
+		return nil, false
+	}
+	target.Set(targetField, source.Get(field))
+
+	return body, true
+}
+
+func _IsScheduleTransaction(body *services.TransactionBody) bool {
+	switch body.GetData().(type) {
+	case *services.TransactionBody_ScheduleCreate, *services.TransactionBody_ScheduleSign, *services.TransactionBody_ScheduleDelete:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/ed25519"
+	"sort"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// _Signers is the set of public keys, by raw bytes, that produced a valid signature over a transaction body
+type _Signers map[string]bool
+
+// _VerifySignatures returns the keys of sigMap whose signature over bodyBytes is valid. Signature pairs must carry
+// the full public key as prefix, which is what the SDK does.
+func _VerifySignatures(bodyBytes []byte, sigMap *services.SignatureMap) _Signers {
+	signers := make(_Signers)
+
+	for _, sigPair := range sigMap.GetSigPair() {
+		prefix := sigPair.GetPubKeyPrefix()
+
+		switch signature := sigPair.GetSignature().(type) {
+		case *services.SignaturePair_Ed25519:
+			if len(prefix) == ed25519.PublicKeySize && ed25519.Verify(prefix, bodyBytes, signature.Ed25519) {
+				signers[string(prefix)] = true
+			}
+		case *services.SignaturePair_ECDSASecp256K1:
+			if _VerifyECDSA(prefix, bodyBytes, signature.ECDSASecp256K1) {
+				signers[string(prefix)] = true
+			}
+		}
+	}
+
+	return signers
+}
+
+func _VerifyECDSA(publicKey []byte, message []byte, signature []byte) bool {
+	if len(signature) != 64 {
+		return false
+	}
+
+	key, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow {
+		return false
+	}
+	if overflow := s.SetByteSlice(signature[32:]); overflow {
+		return false
+	}
+
+	hash := hiero.Keccak256Hash(message)
+	return ecdsa.NewSignature(&r, &s).Verify(hash.Bytes(), key)
+}
+
+// _Add adds every key of other to the set
+func (signers _Signers) _Add(other _Signers) {
+	for key := range other {
+		signers[key] = true
+	}
+}
+
+// _Satisfies reports whether the signers satisfy key. Contract keys can't be satisfied by signatures and never are, and
+// neither are empty key lists and thresholds of 0.
+func (signers _Signers) _Satisfies(key *services.Key) bool {
+	switch k := key.GetKey().(type) {
+	case *services.Key_Ed25519:
+		return signers[string(k.Ed25519)]
+	case *services.Key_ECDSASecp256K1:
+		return signers[string(k.ECDSASecp256K1)]
+	case *services.Key_KeyList:
+		keys := k.KeyList.GetKeys()
+		return len(keys) > 0 && signers._SatisfiesAll(keys)
+	case *services.Key_ThresholdKey:
+		if k.ThresholdKey.GetThreshold() == 0 {
+			return false
+		}
+		satisfied := uint32(0)
+		for _, child := range k.ThresholdKey.GetKeys().GetKeys() {
+			if signers._Satisfies(child) {
+				satisfied++
+			}
+		}
+		return satisfied >= k.ThresholdKey.GetThreshold()
+	default:
+		return false
+	}
+}
+
+// _SatisfiesAll reports whether the signers satisfy every key of keys
+func (signers _Signers) _SatisfiesAll(keys []*services.Key) bool {
+	for _, key := range keys {
+		if !signers._Satisfies(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// _ValidateKey checks that key could be satisfied by signatures: it must not be empty, and thresholds must be between
+// 1 and the number of keys they apply to
+func _ValidateKey(key *services.Key) services.ResponseCodeEnum {
+	switch k := key.GetKey().(type) {
+	case nil:
+		return services.ResponseCodeEnum_KEY_REQUIRED
+	case *services.Key_Ed25519:
+		if len(k.Ed25519) != ed25519.PublicKeySize {
+			return services.ResponseCodeEnum_BAD_ENCODING
+		}
+	case *services.Key_ECDSASecp256K1:
+		if _, err := secp256k1.ParsePubKey(k.ECDSASecp256K1); err != nil {
+			return services.ResponseCodeEnum_BAD_ENCODING
+		}
+	case *services.Key_KeyList:
+		return _ValidateKeys(k.KeyList.GetKeys())
+	case *services.Key_ThresholdKey:
+		keys := k.ThresholdKey.GetKeys().GetKeys()
+		if threshold := k.ThresholdKey.GetThreshold(); threshold == 0 || int(threshold) > len(keys) {
+			return services.ResponseCodeEnum_BAD_ENCODING
+		}
+		return _ValidateKeys(keys)
+	}
+
+	return services.ResponseCodeEnum_OK
+}
+
+func _ValidateKeys(keys []*services.Key) services.ResponseCodeEnum {
+	if len(keys) == 0 {
+		return services.ResponseCodeEnum_KEY_REQUIRED
+	}
+	for _, key := range keys {
+		if status := _ValidateKey(key); status != services.ResponseCodeEnum_OK {
+			return status
+		}
+	}
+
+	return services.ResponseCodeEnum_OK
+}
+
+// _KeyList returns the signers as a key list, sorted by raw bytes
+func (signers _Signers) _KeyList() *services.KeyList {
+	raws := make([]string, 0, len(signers))
+	for raw := range signers {
+		raws = append(raws, raw)
+	}
+	sort.Strings(raws)
+
+	keys := make([]*services.Key, 0, len(raws))
+	for _, raw := range raws {
+		keys = append(keys, _KeyFromRaw([]byte(raw)))
+	}
+
+	return &services.KeyList{Keys: keys}
+}
+
+// _KeyFromPublicKey converts an SDK public key to its protobuf form
+func _KeyFromPublicKey(publicKey hiero.PublicKey) *services.Key {
+	return _KeyFromRaw(publicKey.BytesRaw())
+}
+
+// _KeyFromRaw converts raw public key bytes to a protobuf key; Ed25519 keys are the only 32 byte keys
+func _KeyFromRaw(raw []byte) *services.Key {
+	if len(raw) == ed25519.PublicKeySize {
+		return &services.Key{Key: &services.Key_Ed25519{Ed25519: raw}}
+	}
+
+	return &services.Key{Key: &services.Key_ECDSASecp256K1{ECDSASecp256K1: raw}}
+}
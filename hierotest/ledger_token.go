@@ -0,0 +1,304 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+const (
+	_MaxTokenNameBytes   = 100
+	_MaxTokenSymbolBytes = 100
+)
+
+type _LedgerToken struct {
+	name        string
+	symbol      string
+	memo        string
+	decimals    uint32
+	totalSupply int64
+	supplyType  services.TokenSupplyType
+	maxSupply   int64
+	treasury    int64
+	adminKey    *services.Key
+	supplyKey   *services.Key
+}
+
+// _LedgerTokenService serves the TokenService of a ledger node. Only fungible tokens are supported.
+type _LedgerTokenService struct {
+	services.UnimplementedTokenServiceServer
+	node *_LedgerNode
+}
+
+func (service *_LedgerTokenService) CreateToken(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.TokenService_CreateToken_FullMethodName, transaction)
+}
+
+func (service *_LedgerTokenService) AssociateTokens(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.TokenService_AssociateTokens_FullMethodName, transaction)
+}
+
+func (service *_LedgerTokenService) DissociateTokens(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.TokenService_DissociateTokens_FullMethodName, transaction)
+}
+
+func (service *_LedgerTokenService) MintToken(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.TokenService_MintToken_FullMethodName, transaction)
+}
+
+func (service *_LedgerTokenService) BurnToken(_ context.Context, transaction *services.Transaction) (*services.TransactionResponse, error) {
+	return service.node._Submit(services.TokenService_BurnToken_FullMethodName, transaction)
+}
+
+func (service *_LedgerTokenService) GetTokenInfo(_ context.Context, query *services.Query) (*services.Response, error) {
+	infoQuery := query.GetTokenGetInfo()
+	header, answer := service.node._QueryHeader(infoQuery.GetHeader())
+	response := &services.TokenGetInfoResponse{Header: header}
+
+	if answer {
+		ledger := service.node.ledger
+		ledger.mutex.Lock()
+		defer ledger.mutex.Unlock()
+
+		token, ok := ledger._Token(infoQuery.GetToken())
+		if ok {
+			response.TokenInfo = &services.TokenInfo{
+				TokenId:     infoQuery.GetToken(),
+				Name:        token.name,
+				Symbol:      token.symbol,
+				Memo:        token.memo,
+				Decimals:    token.decimals,
+				TotalSupply: uint64(token.totalSupply),
+				Treasury:    _AccountIDFromNum(token.treasury),
+				AdminKey:    token.adminKey,
+				SupplyKey:   token.supplyKey,
+				TokenType:   services.TokenType_FUNGIBLE_COMMON,
+				SupplyType:  token.supplyType,
+				MaxSupply:   token.maxSupply,
+			}
+		} else {
+			header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+	}
+
+	return &services.Response{Response: &services.Response_TokenGetInfo{TokenGetInfo: response}}, nil
+}
+
+func (ledger *Ledger) _HandleTokenCreate(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetTokenCreation()
+
+	switch {
+	case body.TokenType != services.TokenType_FUNGIBLE_COMMON, len(body.CustomFees) > 0:
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	case body.Name == "":
+		return services.ResponseCodeEnum_MISSING_TOKEN_NAME
+	case len(body.Name) > _MaxTokenNameBytes:
+		return services.ResponseCodeEnum_TOKEN_NAME_TOO_LONG
+	case body.Symbol == "":
+		return services.ResponseCodeEnum_MISSING_TOKEN_SYMBOL
+	case len(body.Symbol) > _MaxTokenSymbolBytes:
+		return services.ResponseCodeEnum_TOKEN_SYMBOL_TOO_LONG
+	case len(body.Memo) > _MaxMemoBytes:
+		return services.ResponseCodeEnum_MEMO_TOO_LONG
+	case int64(body.InitialSupply) < 0:
+		return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+	}
+
+	if body.SupplyType == services.TokenSupplyType_FINITE {
+		if body.MaxSupply <= 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MAX_SUPPLY
+		}
+		if int64(body.InitialSupply) > body.MaxSupply {
+			return services.ResponseCodeEnum_TOKEN_MAX_SUPPLY_REACHED
+		}
+	} else if body.MaxSupply != 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_MAX_SUPPLY
+	}
+
+	treasury, ok := ledger._Account(body.Treasury)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_TREASURY_ACCOUNT_FOR_TOKEN
+	}
+	if !tx.signers._Satisfies(treasury.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.AdminKey != nil && !tx.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	num := ledger._NextEntityNum()
+	ledger.tokens[num] = &_LedgerToken{
+		name:        body.Name,
+		symbol:      body.Symbol,
+		memo:        body.Memo,
+		decimals:    body.Decimals,
+		totalSupply: int64(body.InitialSupply),
+		supplyType:  body.SupplyType,
+		maxSupply:   body.MaxSupply,
+		treasury:    body.Treasury.GetAccountNum(),
+		adminKey:    body.AdminKey,
+		supplyKey:   body.SupplyKey,
+	}
+	treasury.tokens[num] = int64(body.InitialSupply)
+
+	receipt.TokenID = _TokenIDFromNum(num)
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleTokenAssociate(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetTokenAssociate()
+
+	account, ok := ledger._Account(body.Account)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+	if !tx.signers._Satisfies(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	seen := make(map[int64]bool, len(body.Tokens))
+	for _, tokenID := range body.Tokens {
+		if _, ok := ledger._Token(tokenID); !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+		if seen[tokenID.TokenNum] {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+		if _, ok := account.tokens[tokenID.TokenNum]; ok {
+			return services.ResponseCodeEnum_TOKEN_ALREADY_ASSOCIATED_TO_ACCOUNT
+		}
+		seen[tokenID.TokenNum] = true
+	}
+
+	for tokenNum := range seen {
+		account.tokens[tokenNum] = 0
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleTokenDissociate(tx *_LedgerTransaction, _ *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetTokenDissociate()
+
+	account, ok := ledger._Account(body.Account)
+	if !ok {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+	if !tx.signers._Satisfies(account.key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	seen := make(map[int64]bool, len(body.Tokens))
+	for _, tokenID := range body.Tokens {
+		token, ok := ledger._Token(tokenID)
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+		if seen[tokenID.TokenNum] {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+
+		balance, ok := account.tokens[tokenID.TokenNum]
+		if !ok {
+			return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+		}
+		if token.treasury == body.Account.GetAccountNum() {
+			return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+		}
+		if balance != 0 {
+			return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+		}
+		seen[tokenID.TokenNum] = true
+	}
+
+	for tokenNum := range seen {
+		delete(account.tokens, tokenNum)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleTokenMint(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetTokenMint()
+
+	token, status := ledger._SupplyToken(tx, body.GetToken())
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+	if len(body.Metadata) > 0 {
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+
+	amount := int64(body.Amount)
+	if amount <= 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_MINT_AMOUNT
+	}
+	if token.supplyType == services.TokenSupplyType_FINITE && token.totalSupply+amount > token.maxSupply {
+		return services.ResponseCodeEnum_TOKEN_MAX_SUPPLY_REACHED
+	}
+
+	token.totalSupply += amount
+	ledger.accounts[token.treasury].tokens[body.Token.TokenNum] += amount
+
+	receipt.NewTotalSupply = uint64(token.totalSupply)
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *Ledger) _HandleTokenBurn(tx *_LedgerTransaction, receipt *services.TransactionReceipt) services.ResponseCodeEnum {
+	body := tx.body.GetTokenBurn()
+
+	token, status := ledger._SupplyToken(tx, body.GetToken())
+	if status != services.ResponseCodeEnum_OK {
+		return status
+	}
+	if len(body.SerialNumbers) > 0 {
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+
+	amount := int64(body.Amount)
+	if amount <= 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_BURN_AMOUNT
+	}
+
+	treasury := ledger.accounts[token.treasury]
+	if treasury.tokens[body.Token.TokenNum] < amount {
+		return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+	}
+
+	token.totalSupply -= amount
+	treasury.tokens[body.Token.TokenNum] -= amount
+
+	receipt.NewTotalSupply = uint64(token.totalSupply)
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _SupplyToken returns the token whose supply a mint or burn changes, once its supply key signed
+func (ledger *Ledger) _SupplyToken(tx *_LedgerTransaction, tokenID *services.TokenID) (*_LedgerToken, services.ResponseCodeEnum) {
+	token, ok := ledger._Token(tokenID)
+	if !ok {
+		return nil, services.ResponseCodeEnum_INVALID_TOKEN_ID
+	}
+	if token.supplyKey == nil {
+		return nil, services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY
+	}
+	if !tx.signers._Satisfies(token.supplyKey) {
+		return nil, services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return token, services.ResponseCodeEnum_OK
+}
+
+func (ledger *Ledger) _Token(tokenID *services.TokenID) (*_LedgerToken, bool) {
+	if tokenID.GetShardNum() != 0 || tokenID.GetRealmNum() != 0 {
+		return nil, false
+	}
+
+	token, ok := ledger.tokens[tokenID.GetTokenNum()]
+	return token, ok
+}
+
+func _TokenIDFromNum(num int64) *services.TokenID {
+	return &services.TokenID{TokenNum: num}
+}
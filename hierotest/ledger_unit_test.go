@@ -0,0 +1,483 @@
+//go:build all || unit
+// +build all unit
+
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _ReceiptStatus(t *testing.T, err error) hiero.Status {
+	t.Helper()
+
+	var receiptErr hiero.ErrHederaReceiptStatus
+	require.ErrorAs(t, err, &receiptErr)
+	return receiptErr.Status
+}
+
+func _PreCheckStatus(t *testing.T, err error) hiero.Status {
+	t.Helper()
+
+	var preCheckErr hiero.ErrHederaPreCheckStatus
+	require.ErrorAs(t, err, &preCheckErr)
+	return preCheckErr.Status
+}
+
+func TestUnitLedgerTransfer(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger(WithNodeCount(2))
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+	operatorID := client.GetOperatorAccountID()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	resp, err := hiero.NewAccountCreateTransaction().
+		SetKey(key.PublicKey()).
+		SetInitialBalance(hiero.NewHbar(10)).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, hiero.StatusSuccess, receipt.Status)
+	require.NotNil(t, receipt.AccountID)
+	require.NotNil(t, receipt.ExchangeRate)
+	accountID := *receipt.AccountID
+
+	resp, err = hiero.NewTransferTransaction().
+		AddHbarTransfer(operatorID, hiero.NewHbar(-5)).
+		AddHbarTransfer(accountID, hiero.NewHbar(5)).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	balance, err := hiero.NewAccountBalanceQuery().SetAccountID(accountID).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, hiero.NewHbar(15), balance.Hbars)
+
+	// Debiting the account needs its signature
+	resp, err = hiero.NewTransferTransaction().
+		AddHbarTransfer(accountID, hiero.NewHbar(-3)).
+		AddHbarTransfer(operatorID, hiero.NewHbar(3)).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.Equal(t, hiero.StatusInvalidSignature, _ReceiptStatus(t, err))
+
+	frozen, err := hiero.NewTransferTransaction().
+		AddHbarTransfer(accountID, hiero.NewHbar(-3)).
+		AddHbarTransfer(operatorID, hiero.NewHbar(3)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	resp, err = frozen.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	hbars, err := ledger.Balance(accountID)
+	require.NoError(t, err)
+	require.Equal(t, hiero.NewHbar(12), hbars)
+
+	resp, err = hiero.NewTransferTransaction().
+		AddHbarTransfer(operatorID, hiero.NewHbar(-1)).
+		AddHbarTransfer(accountID, hiero.NewHbar(2)).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.Equal(t, hiero.StatusInvalidAccountAmounts, _ReceiptStatus(t, err))
+
+	info, err := hiero.NewAccountInfoQuery().SetAccountID(accountID).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey().String(), info.Key.String())
+	require.Equal(t, hiero.NewHbar(12), info.Balance)
+}
+
+func TestUnitLedgerPrecheck(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+	otherKey, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	otherID := ledger.CreateAccount(otherKey.PublicKey(), hiero.NewHbar(1))
+
+	// The payer must sign
+	_, err = hiero.NewTransferTransaction().
+		SetTransactionID(hiero.TransactionIDGenerate(otherID)).
+		AddHbarTransfer(otherID, hiero.HbarFromTinybar(-1)).
+		AddHbarTransfer(client.GetOperatorAccountID(), hiero.HbarFromTinybar(1)).
+		Execute(client)
+	require.Equal(t, hiero.StatusInvalidSignature, _PreCheckStatus(t, err))
+
+	_, err = hiero.NewTransferTransaction().
+		SetTransactionID(hiero.TransactionIDGenerate(hiero.AccountID{Account: 99999})).
+		AddHbarTransfer(otherID, hiero.HbarFromTinybar(-1)).
+		AddHbarTransfer(client.GetOperatorAccountID(), hiero.HbarFromTinybar(1)).
+		Execute(client)
+	require.Equal(t, hiero.StatusPayerAccountNotFound, _PreCheckStatus(t, err))
+
+	transactionID := hiero.TransactionIDGenerate(client.GetOperatorAccountID())
+	for i, expected := range []hiero.Status{hiero.StatusOk, hiero.StatusDuplicateTransaction} {
+		_, err = hiero.NewTopicCreateTransaction().
+			SetTransactionID(transactionID).
+			Execute(client)
+		if i == 0 {
+			require.NoError(t, err)
+		} else {
+			require.Equal(t, expected, _PreCheckStatus(t, err))
+		}
+	}
+
+	_, err = hiero.NewTransactionReceiptQuery().
+		SetTransactionID(hiero.TransactionIDGenerate(client.GetOperatorAccountID())).
+		SetMaxRetry(1).
+		Execute(client)
+	require.Error(t, err)
+}
+
+func TestUnitLedgerUnsatisfiableKeys(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+	operatorID := client.GetOperatorAccountID()
+
+	// Node accounts can't be debited
+	resp, err := hiero.NewTransferTransaction().
+		SetNodeAccountIDs([]hiero.AccountID{{Account: 3}}).
+		AddHbarTransfer(hiero.AccountID{Account: 3}, hiero.HbarFromTinybar(-1)).
+		AddHbarTransfer(operatorID, hiero.HbarFromTinybar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.Equal(t, hiero.StatusInvalidSignature, _ReceiptStatus(t, err))
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	for expected, accountKey := range map[hiero.Status]hiero.Key{
+		hiero.StatusKeyRequired: hiero.NewKeyList(),
+		hiero.StatusBadEncoding: hiero.KeyListWithThreshold(2).Add(key.PublicKey()),
+	} {
+		resp, err = hiero.NewAccountCreateTransaction().
+			SetKey(accountKey).
+			Execute(client)
+		require.NoError(t, err)
+		_, err = resp.GetReceipt(client)
+		require.Equal(t, expected, _ReceiptStatus(t, err))
+	}
+
+	signers := _Signers{string(key.PublicKey().BytesRaw()): true}
+	require.False(t, signers._Satisfies(&services.Key{Key: &services.Key_KeyList{KeyList: &services.KeyList{}}}))
+	require.False(t, signers._Satisfies(&services.Key{Key: &services.Key_ThresholdKey{ThresholdKey: &services.ThresholdKey{
+		Threshold: 0,
+		Keys:      &services.KeyList{Keys: []*services.Key{_KeyFromPublicKey(key.PublicKey())}},
+	}}}))
+	require.True(t, signers._Satisfies(&services.Key{Key: &services.Key_ThresholdKey{ThresholdKey: &services.ThresholdKey{
+		Threshold: 1,
+		Keys:      &services.KeyList{Keys: []*services.Key{_KeyFromPublicKey(key.PublicKey())}},
+	}}}))
+}
+
+func TestUnitLedgerToken(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+	operatorID := client.GetOperatorAccountID()
+	operatorKey := ledger.OperatorKey()
+
+	resp, err := hiero.NewTokenCreateTransaction().
+		SetTokenName("Test").
+		SetTokenSymbol("TST").
+		SetDecimals(2).
+		SetInitialSupply(1000).
+		SetTreasuryAccountID(operatorID).
+		SetAdminKey(operatorKey.PublicKey()).
+		SetSupplyKey(operatorKey.PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.TokenID)
+	tokenID := *receipt.TokenID
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	accountID := ledger.CreateAccount(key.PublicKey(), hiero.NewHbar(1))
+
+	transfer := func() error {
+		resp, err := hiero.NewTransferTransaction().
+			AddTokenTransfer(tokenID, operatorID, -100).
+			AddTokenTransfer(tokenID, accountID, 100).
+			Execute(client)
+		require.NoError(t, err)
+		_, err = resp.GetReceipt(client)
+		return err
+	}
+
+	require.Equal(t, hiero.StatusTokenNotAssociatedToAccount, _ReceiptStatus(t, transfer()))
+
+	associate, err := hiero.NewTokenAssociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	resp, err = associate.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	require.NoError(t, transfer())
+
+	tokenBalance, err := ledger.TokenBalance(accountID, tokenID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), tokenBalance)
+
+	balance, err := hiero.NewAccountBalanceQuery().SetAccountID(operatorID).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(900), balance.Tokens.Get(tokenID))
+
+	resp, err = hiero.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetAmount(50).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1050), receipt.TotalSupply)
+
+	info, err := hiero.NewTokenInfoQuery().SetTokenID(tokenID).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, "TST", info.Symbol)
+	require.Equal(t, uint32(2), info.Decimals)
+	require.Equal(t, uint64(1050), info.TotalSupply)
+	require.Equal(t, operatorID, info.Treasury)
+}
+
+func TestUnitLedgerTopicMessages(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+	submitKey, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	resp, err := hiero.NewTopicCreateTransaction().
+		SetSubmitKey(submitKey.PublicKey()).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.TopicID)
+	topicID := *receipt.TopicID
+
+	var mutex sync.Mutex
+	received := make([]hiero.TopicMessage, 0)
+	done := make(chan struct{})
+	handle, err := hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetLimit(2).
+		SetRunningHashVerifier(hiero.NewTopicRunningHashVerifier()).
+		SetVerificationErrorHandler(func(err hiero.ErrTopicMessageVerification) {
+			t.Errorf("unexpected verification error: %v", err)
+		}).
+		SetCompletionHandler(func() {
+			close(done)
+		}).
+		Subscribe(client, func(message hiero.TopicMessage) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			received = append(received, message)
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	resp, err = hiero.NewTopicMessageSubmitTransaction().
+		SetTopicID(topicID).
+		SetMessage([]byte("unsigned")).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.SetValidateStatus(true).GetReceipt(client)
+	require.Equal(t, hiero.StatusInvalidSignature, _ReceiptStatus(t, err))
+
+	receipts := make([]hiero.TransactionReceipt, 0)
+	for _, message := range []string{"first", "second"} {
+		submit, err := hiero.NewTopicMessageSubmitTransaction().
+			SetTopicID(topicID).
+			SetMessage([]byte(message)).
+			FreezeWith(client)
+		require.NoError(t, err)
+		resp, err := submit.Sign(submitKey).Execute(client)
+		require.NoError(t, err)
+		receipt, err := resp.GetReceipt(client)
+		require.NoError(t, err)
+		receipts = append(receipts, receipt)
+	}
+
+	require.Equal(t, uint64(1), receipts[0].TopicSequenceNumber)
+	require.Equal(t, uint64(2), receipts[1].TopicSequenceNumber)
+	require.Equal(t, uint64(3), receipts[1].TopicRunningHashVersion)
+	require.Len(t, receipts[1].TopicRunningHash, 48)
+	require.NotEqual(t, receipts[0].TopicRunningHash, receipts[1].TopicRunningHash)
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Len(t, received, 2)
+	require.Equal(t, []byte("first"), received[0].Contents)
+	require.Equal(t, []byte("second"), received[1].Contents)
+	require.Equal(t, receipts[1].TopicRunningHash, received[1].RunningHash)
+
+	info, err := hiero.NewTopicInfoQuery().SetTopicID(topicID).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), info.SequenceNumber)
+	require.Equal(t, receipts[1].TopicRunningHash, info.RunningHash)
+}
+
+func TestUnitLedgerRunningHash(t *testing.T) {
+	t.Parallel()
+
+	// The 172 bytes of a version 3 running hash input, framed by a Java ObjectOutputStream the way consensus nodes
+	// hash it: the stream header aced0005 and a block data record 77ac
+	runningHash := _RunningHash(
+		make([]byte, 48),
+		&services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1001}},
+		&services.TopicID{TopicNum: 7},
+		time.Unix(1700000000, 5),
+		1,
+		[]byte("hello"),
+	)
+	require.Equal(t, "018eb13ece524dbfc2482c1dbfe7d48a8679e6a78fd9bfd916a79bcdcbaf90bc88c2d144965abcc2824905a355e81bb0", hex.EncodeToString(runningHash))
+}
+
+func TestUnitLedgerFile(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+
+	resp, err := hiero.NewFileCreateTransaction().
+		SetKeys(ledger.OperatorKey().PublicKey()).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.FileID)
+	fileID := *receipt.FileID
+
+	resp, err = hiero.NewFileAppendTransaction().
+		SetFileID(fileID).
+		SetContents([]byte(", world")).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	contents, err := hiero.NewFileContentsQuery().SetFileID(fileID).Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello, world"), contents)
+
+	resp, err = hiero.NewFileDeleteTransaction().SetFileID(fileID).Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	info, err := hiero.NewFileInfoQuery().SetFileID(fileID).Execute(client)
+	require.NoError(t, err)
+	require.True(t, info.IsDeleted)
+}
+
+func TestUnitLedgerSchedule(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	client := ledger.Client()
+	operatorID := client.GetOperatorAccountID()
+
+	key, err := hiero.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	accountID := ledger.CreateAccount(key.PublicKey(), hiero.NewHbar(10))
+
+	scheduled, err := hiero.NewScheduleCreateTransaction().
+		SetScheduledTransaction(hiero.NewTransferTransaction().
+			AddHbarTransfer(accountID, hiero.NewHbar(-4)).
+			AddHbarTransfer(operatorID, hiero.NewHbar(4)))
+	require.NoError(t, err)
+	resp, err := scheduled.Execute(client)
+	require.NoError(t, err)
+	receipt, err := resp.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.ScheduleID)
+	require.NotNil(t, receipt.ScheduledTransactionID)
+	scheduleID := *receipt.ScheduleID
+	scheduledTransactionID := *receipt.ScheduledTransactionID
+	require.True(t, scheduledTransactionID.GetScheduled())
+
+	hbars, err := ledger.Balance(accountID)
+	require.NoError(t, err)
+	require.Equal(t, hiero.NewHbar(10), hbars)
+
+	sign, err := hiero.NewScheduleSignTransaction().
+		SetScheduleID(scheduleID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	resp, err = sign.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = resp.GetReceipt(client)
+	require.NoError(t, err)
+
+	hbars, err = ledger.Balance(accountID)
+	require.NoError(t, err)
+	require.Equal(t, hiero.NewHbar(6), hbars)
+
+	receipt, err = hiero.NewTransactionReceiptQuery().
+		SetTransactionID(scheduledTransactionID).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, hiero.StatusSuccess, receipt.Status)
+
+	info, err := hiero.NewScheduleInfoQuery().SetScheduleID(scheduleID).Execute(client)
+	require.NoError(t, err)
+	require.NotNil(t, info.ExecutedAt)
+	require.Len(t, info.Signers.GetKeys(), 2)
+}
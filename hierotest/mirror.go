@@ -0,0 +1,243 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc"
+)
+
+// MirrorNode is a fake mirror node serving the ConsensusService used by TopicMessageQuery, and the address book.
+//
+// A subscription receives the messages added for its topic that match its start time, end time and limit, including
+// messages added while it is open. Subscriptions with an end time complete once every stored message was sent;
+// the others stay open until the limit is reached, the subscriber unsubscribes or the network is closed.
+type MirrorNode struct {
+	mirror.UnimplementedConsensusServiceServer
+	mirror.UnimplementedNetworkServiceServer
+
+	listener  net.Listener
+	server    *grpc.Server
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mutex              sync.Mutex
+	changed            chan struct{}
+	topics             map[string][]*mirror.ConsensusTopicResponse
+	subscriptionErrors []error
+	subscriptions      []*mirror.ConsensusTopicQuery
+	nodes              []*services.NodeAddress
+}
+
+func _NewMirrorNode() (*MirrorNode, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, err
+	}
+
+	mirrorNode := &MirrorNode{
+		listener: listener,
+		server:   grpc.NewServer(),
+		closed:   make(chan struct{}),
+		changed:  make(chan struct{}),
+		topics:   make(map[string][]*mirror.ConsensusTopicResponse),
+	}
+
+	mirror.RegisterConsensusServiceServer(mirrorNode.server, mirrorNode)
+	mirror.RegisterNetworkServiceServer(mirrorNode.server, mirrorNode)
+
+	go func() {
+		_ = mirrorNode.server.Serve(listener)
+	}()
+
+	return mirrorNode, nil
+}
+
+// Address returns the host:port the mirror node listens on.
+func (mirrorNode *MirrorNode) Address() string {
+	return mirrorNode.listener.Addr().String()
+}
+
+// AddTopicMessages stores messages for topicID and delivers them to open subscriptions. Messages must be added in
+// consensus order.
+func (mirrorNode *MirrorNode) AddTopicMessages(topicID hiero.TopicID, messages ...*mirror.ConsensusTopicResponse) *MirrorNode {
+	mirrorNode.mutex.Lock()
+	defer mirrorNode.mutex.Unlock()
+
+	key := topicID.String()
+	mirrorNode.topics[key] = append(mirrorNode.topics[key], messages...)
+	mirrorNode._NotifyLocked()
+
+	return mirrorNode
+}
+
+// PublishTopicMessage stores a message for topicID with the next sequence number and a consensus timestamp after
+// every stored message, and delivers it to open subscriptions. The running hash is left empty.
+func (mirrorNode *MirrorNode) PublishTopicMessage(topicID hiero.TopicID, contents []byte) *mirror.ConsensusTopicResponse {
+	mirrorNode.mutex.Lock()
+	defer mirrorNode.mutex.Unlock()
+
+	key := topicID.String()
+	messages := mirrorNode.topics[key]
+
+	timestamp := time.Now()
+	var sequenceNumber uint64 = 1
+	if len(messages) > 0 {
+		last := messages[len(messages)-1]
+		sequenceNumber = last.SequenceNumber + 1
+		if previous := _TimeFromProtobuf(last.ConsensusTimestamp); !timestamp.After(previous) {
+			timestamp = previous.Add(time.Nanosecond)
+		}
+	}
+
+	message := &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: _TimeToProtobuf(timestamp),
+		Message:            contents,
+		SequenceNumber:     sequenceNumber,
+		RunningHashVersion: 3,
+	}
+
+	mirrorNode.topics[key] = append(messages, message)
+	mirrorNode._NotifyLocked()
+
+	return message
+}
+
+// EnqueueSubscriptionError makes the next subscriptions fail with the given errors, in order. Use status.Error to
+// pick the gRPC code, e.g. codes.NotFound for a topic the mirror node doesn't know yet, which the SDK retries.
+func (mirrorNode *MirrorNode) EnqueueSubscriptionError(errs ...error) *MirrorNode {
+	mirrorNode.mutex.Lock()
+	defer mirrorNode.mutex.Unlock()
+
+	mirrorNode.subscriptionErrors = append(mirrorNode.subscriptionErrors, errs...)
+	return mirrorNode
+}
+
+// SetNodes sets the address book returned to the SDK when it updates its network from the mirror node.
+func (mirrorNode *MirrorNode) SetNodes(nodes ...*services.NodeAddress) *MirrorNode {
+	mirrorNode.mutex.Lock()
+	defer mirrorNode.mutex.Unlock()
+
+	mirrorNode.nodes = nodes
+	return mirrorNode
+}
+
+// Subscriptions returns the topic queries received by the mirror node, in order.
+func (mirrorNode *MirrorNode) Subscriptions() []*mirror.ConsensusTopicQuery {
+	mirrorNode.mutex.Lock()
+	defer mirrorNode.mutex.Unlock()
+
+	return append([]*mirror.ConsensusTopicQuery(nil), mirrorNode.subscriptions...)
+}
+
+// SubscribeTopic implements mirror.ConsensusServiceServer
+func (mirrorNode *MirrorNode) SubscribeTopic(query *mirror.ConsensusTopicQuery, stream mirror.ConsensusService_SubscribeTopicServer) error {
+	mirrorNode.mutex.Lock()
+	mirrorNode.subscriptions = append(mirrorNode.subscriptions, query)
+	if len(mirrorNode.subscriptionErrors) > 0 {
+		err := mirrorNode.subscriptionErrors[0]
+		mirrorNode.subscriptionErrors = mirrorNode.subscriptionErrors[1:]
+		mirrorNode.mutex.Unlock()
+		return err
+	}
+	mirrorNode.mutex.Unlock()
+
+	topicID := query.GetTopicID()
+	key := fmt.Sprintf("%d.%d.%d", topicID.GetShardNum(), topicID.GetRealmNum(), topicID.GetTopicNum())
+
+	var startTime, endTime *time.Time
+	if query.ConsensusStartTime != nil {
+		start := _TimeFromProtobuf(query.ConsensusStartTime)
+		startTime = &start
+	}
+	if query.ConsensusEndTime != nil {
+		end := _TimeFromProtobuf(query.ConsensusEndTime)
+		endTime = &end
+	}
+
+	next := 0
+	var sent uint64
+	for {
+		mirrorNode.mutex.Lock()
+		messages := mirrorNode.topics[key]
+		changed := mirrorNode.changed
+		mirrorNode.mutex.Unlock()
+
+		for ; next < len(messages); next++ {
+			message := messages[next]
+			timestamp := _TimeFromProtobuf(message.ConsensusTimestamp)
+			if startTime != nil && timestamp.Before(*startTime) {
+				continue
+			}
+			if endTime != nil && !timestamp.Before(*endTime) {
+				return nil
+			}
+
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+
+			sent++
+			if query.Limit > 0 && sent >= query.Limit {
+				return nil
+			}
+		}
+
+		if endTime != nil {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return nil
+		case <-mirrorNode.closed:
+			return nil
+		}
+	}
+}
+
+// GetNodes implements mirror.NetworkServiceServer
+func (mirrorNode *MirrorNode) GetNodes(_ *mirror.AddressBookQuery, stream mirror.NetworkService_GetNodesServer) error {
+	mirrorNode.mutex.Lock()
+	nodes := append([]*services.NodeAddress(nil), mirrorNode.nodes...)
+	mirrorNode.mutex.Unlock()
+
+	for _, node := range nodes {
+		if err := stream.Send(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (mirrorNode *MirrorNode) _NotifyLocked() {
+	close(mirrorNode.changed)
+	mirrorNode.changed = make(chan struct{})
+}
+
+func (mirrorNode *MirrorNode) close() {
+	mirrorNode.closeOnce.Do(func() {
+		close(mirrorNode.closed)
+		mirrorNode.server.Stop()
+	})
+}
+
+func _TimeFromProtobuf(timestamp *services.Timestamp) time.Time {
+	return time.Unix(timestamp.GetSeconds(), int64(timestamp.GetNanos()))
+}
+
+func _TimeToProtobuf(t time.Time) *services.Timestamp {
+	return &services.Timestamp{
+		Seconds: t.Unix(),
+		Nanos:   int32(t.Nanosecond()),
+	}
+}
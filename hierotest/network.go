@@ -0,0 +1,215 @@
+// Package hierotest runs an in-process Hiero network for unit testing code that depends on the SDK.
+//
+// Every node is a local gRPC server that answers with responses scripted by the test, per node and optionally per
+// RPC, and records the requests it received. A fake mirror node serves TopicMessageQuery subscriptions from
+// messages added by the test.
+//
+//	network, err := hierotest.NewNetwork(hierotest.WithNodeCount(2))
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer network.Close()
+//
+//	network.Node(0).Enqueue(&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK})
+//	resp, err := hiero.NewTransferTransaction().
+//		SetNodeAccountIDs([]hiero.AccountID{network.Node(0).AccountID()}).
+//		...
+//		Execute(network.Client())
+//
+// A Ledger instead keeps state: its nodes handle transfers and the account, token, topic, file and schedule
+// transactions they support like a real network would, checking signatures and storing receipts, so code can be
+// tested end to end without scripting any response.
+//
+//	ledger, err := hierotest.NewLedger()
+//	...
+//	resp, err := hiero.NewTransferTransaction().
+//		AddHbarTransfer(ledger.Client().GetOperatorAccountID(), hiero.NewHbar(-1)).
+//		AddHbarTransfer(accountID, hiero.NewHbar(1)).
+//		Execute(ledger.Client())
+//	receipt, err := resp.GetReceipt(ledger.Client())
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"sync"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+)
+
+// DefaultOperatorAccountID is the operator of the Client returned by Network.Client unless WithOperator is used.
+var DefaultOperatorAccountID = hiero.AccountID{Account: 1800}
+
+type config struct {
+	nodeCount          int
+	operatorAccountID  hiero.AccountID
+	operatorPrivateKey *hiero.PrivateKey
+}
+
+// Option configures a Network created with NewNetwork or a Ledger created with NewLedger.
+type Option func(*config)
+
+// WithNodeCount sets how many consensus nodes the network has. Nodes get the account IDs 0.0.3, 0.0.4, ...
+// The default is a single node.
+func WithNodeCount(count int) Option {
+	return func(c *config) {
+		c.nodeCount = count
+	}
+}
+
+// WithOperator sets the operator of the Client returned by Network.Client. By default the operator is
+// DefaultOperatorAccountID with a freshly generated Ed25519 key.
+func WithOperator(accountID hiero.AccountID, privateKey hiero.PrivateKey) Option {
+	return func(c *config) {
+		c.operatorAccountID = accountID
+		c.operatorPrivateKey = &privateKey
+	}
+}
+
+// Network is a set of scripted consensus nodes and a fake mirror node listening on localhost.
+type Network struct {
+	nodes       []*Node
+	mirror      *MirrorNode
+	client      *hiero.Client
+	operatorKey hiero.PrivateKey
+	closeOnce   sync.Once
+}
+
+func _NewConfig(options []Option) (config, error) {
+	c := config{
+		nodeCount:         1,
+		operatorAccountID: DefaultOperatorAccountID,
+	}
+	for _, option := range options {
+		option(&c)
+	}
+
+	if c.nodeCount < 1 {
+		return c, fmt.Errorf("hierotest: a network needs at least one node, got %d", c.nodeCount)
+	}
+
+	if c.operatorPrivateKey == nil {
+		key, err := hiero.PrivateKeyGenerateEd25519()
+		if err != nil {
+			return c, err
+		}
+		c.operatorPrivateKey = &key
+	}
+
+	return c, nil
+}
+
+// _NewClient creates a Client for the given nodes and mirror node without any retry backoff
+func _NewClient(addresses map[string]hiero.AccountID, mirrorAddress string, c config) *hiero.Client {
+	client := hiero.ClientForNetwork(addresses)
+	client.SetMirrorNetwork([]string{mirrorAddress})
+	client.SetOperator(c.operatorAccountID, *c.operatorPrivateKey)
+	client.SetMinBackoff(0)
+	client.SetMaxBackoff(0)
+	client.SetMinNodeReadmitTime(0)
+	client.SetMaxNodeReadmitTime(0)
+	client.SetNodeMinBackoff(0)
+	client.SetNodeMaxBackoff(0)
+
+	return client
+}
+
+// NewNetwork starts the nodes and the mirror node and creates a Client for them. Close must be called to stop them.
+func NewNetwork(options ...Option) (*Network, error) {
+	c, err := _NewConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	network := &Network{
+		nodes:       make([]*Node, 0, c.nodeCount),
+		operatorKey: *c.operatorPrivateKey,
+	}
+
+	addresses := make(map[string]hiero.AccountID, c.nodeCount)
+	for i := 0; i < c.nodeCount; i++ {
+		node, err := _NewNode(hiero.AccountID{Account: uint64(3 + i)})
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+
+		network.nodes = append(network.nodes, node)
+		addresses[node.Address()] = node.AccountID()
+	}
+
+	mirrorNode, err := _NewMirrorNode()
+	if err != nil {
+		network.Close()
+		return nil, err
+	}
+	network.mirror = mirrorNode
+
+	network.client = _NewClient(addresses, mirrorNode.Address(), c)
+
+	return network, nil
+}
+
+// Client returns a Client connected to the network, with the operator and retry backoff set to zero.
+func (network *Network) Client() *hiero.Client {
+	return network.client
+}
+
+// OperatorKey returns the private key of the Client's operator.
+func (network *Network) OperatorKey() hiero.PrivateKey {
+	return network.operatorKey
+}
+
+// Node returns the node at index; the node at index 0 has the account ID 0.0.3.
+func (network *Network) Node(index int) *Node {
+	return network.nodes[index]
+}
+
+// NodeForAccountID returns the node with the given account ID, or nil.
+func (network *Network) NodeForAccountID(accountID hiero.AccountID) *Node {
+	for _, node := range network.nodes {
+		if node.accountID.String() == accountID.String() {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// Nodes returns all consensus nodes of the network.
+func (network *Network) Nodes() []*Node {
+	return append([]*Node(nil), network.nodes...)
+}
+
+// NodeAccountIDs returns the account IDs of all consensus nodes, in order.
+func (network *Network) NodeAccountIDs() []hiero.AccountID {
+	accountIDs := make([]hiero.AccountID, 0, len(network.nodes))
+	for _, node := range network.nodes {
+		accountIDs = append(accountIDs, node.accountID)
+	}
+
+	return accountIDs
+}
+
+// Mirror returns the fake mirror node.
+func (network *Network) Mirror() *MirrorNode {
+	return network.mirror
+}
+
+// Close closes the Client and stops all servers. Calling it again does nothing.
+func (network *Network) Close() {
+	network.closeOnce.Do(func() {
+		if network.client != nil {
+			_ = network.client.Close()
+		}
+
+		for _, node := range network.nodes {
+			node.close()
+		}
+
+		if network.mirror != nil {
+			network.mirror.close()
+		}
+	})
+}
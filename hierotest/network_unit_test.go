@@ -0,0 +1,437 @@
+//go:build all || unit
+// +build all unit
+
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func _BalanceResponse(code services.ResponseCodeEnum, tinybars uint64) *services.Response {
+	return &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: code, ResponseType: services.ResponseType_ANSWER_ONLY},
+				AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				Balance:   tinybars,
+			},
+		},
+	}
+}
+
+func TestUnitNetworkTransaction(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork(WithNodeCount(2))
+	require.NoError(t, err)
+	defer network.Close()
+
+	require.Equal(t, []hiero.AccountID{{Account: 3}, {Account: 4}}, network.NodeAccountIDs())
+	require.Equal(t, DefaultOperatorAccountID, network.Client().GetOperatorAccountID())
+
+	node := network.NodeForAccountID(hiero.AccountID{Account: 3})
+	require.NotNil(t, node)
+	// The handler runs on the server's goroutine, so the request is checked by the test once it was answered
+	handled := make(chan *services.Transaction, 1)
+	node.EnqueueFor(services.FileService_CreateFile_FullMethodName,
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY},
+		func(request *services.Transaction) *services.TransactionResponse {
+			handled <- request
+			return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+		},
+	)
+
+	resp, err := hiero.NewFileCreateTransaction().
+		SetNodeAccountIDs([]hiero.AccountID{node.AccountID()}).
+		SetContents([]byte("hello")).
+		Execute(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, node.AccountID(), resp.NodeID)
+	require.NotEmpty(t, (<-handled).SignedTransactionBytes)
+
+	require.Zero(t, node.Pending())
+	requests := node.RequestsFor(services.FileService_CreateFile_FullMethodName)
+	require.Len(t, requests, 2)
+	require.Empty(t, network.Node(1).Requests())
+
+	body, err := requests[1].TransactionBody()
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), body.GetFileCreate().GetContents())
+	require.Equal(t, int64(1800), body.GetTransactionID().GetAccountID().GetAccountNum())
+}
+
+func TestUnitNetworkQuery(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	network.Node(0).Enqueue(
+		_BalanceResponse(services.ResponseCodeEnum_OK, 2000),
+		status.Error(codes.Unavailable, "down"),
+		_BalanceResponse(services.ResponseCodeEnum_OK, 3000),
+	)
+
+	balance, err := hiero.NewAccountBalanceQuery().
+		SetAccountID(hiero.AccountID{Account: 1800}).
+		Execute(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, hiero.HbarFromTinybar(2000), balance.Hbars)
+
+	balance, err = hiero.NewAccountBalanceQuery().
+		SetAccountID(hiero.AccountID{Account: 1800}).
+		Execute(network.Client())
+	require.NoError(t, err)
+	require.Equal(t, hiero.HbarFromTinybar(3000), balance.Hbars)
+
+	requests := network.Node(0).Requests()
+	require.Len(t, requests, 3)
+	require.Equal(t, services.CryptoService_CryptoGetBalance_FullMethodName, requests[0].Method)
+	require.NotNil(t, requests[0].Query)
+	require.Nil(t, requests[0].Transaction)
+	require.Equal(t, int64(1800), requests[0].Query.GetCryptogetAccountBalance().GetAccountID().GetAccountNum())
+}
+
+func TestUnitNetworkNoResponse(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	_, err = hiero.NewAccountBalanceQuery().
+		SetAccountID(hiero.AccountID{Account: 1800}).
+		SetMaxRetry(1).
+		Execute(network.Client())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "node 0.0.3 has no response for "+services.CryptoService_CryptoGetBalance_FullMethodName)
+}
+
+func TestUnitNetworkCloseTwice(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	network.Close()
+	network.Close()
+
+	ledger, err := NewLedger()
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	ledger.Close()
+}
+
+func TestUnitNetworkTopicMessageQuery(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	topicID := hiero.TopicID{Topic: 1000}
+	network.Mirror().PublishTopicMessage(topicID, []byte("first"))
+	network.Mirror().PublishTopicMessage(hiero.TopicID{Topic: 2000}, []byte("other topic"))
+
+	var mutex sync.Mutex
+	received := make([]hiero.TopicMessage, 0)
+	done := make(chan struct{})
+
+	handle, err := hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetLimit(2).
+		SetCompletionHandler(func() {
+			close(done)
+		}).
+		Subscribe(network.Client(), func(message hiero.TopicMessage) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			received = append(received, message)
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	// Messages published while the subscription is open are delivered too
+	network.Mirror().PublishTopicMessage(topicID, []byte("second"))
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Len(t, received, 2)
+	require.Equal(t, []byte("first"), received[0].Contents)
+	require.Equal(t, uint64(1), received[0].SequenceNumber)
+	require.Equal(t, []byte("second"), received[1].Contents)
+	require.Equal(t, uint64(2), received[1].SequenceNumber)
+
+	subscriptions := network.Mirror().Subscriptions()
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, int64(1000), subscriptions[0].GetTopicID().GetTopicNum())
+}
+
+func TestUnitNetworkTopicMessageQueryCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	topicID := hiero.TopicID{Topic: 1000}
+	start := time.Unix(1700000000, 0)
+	chunkInfo := func(number int32) *services.ConsensusMessageChunkInfo {
+		return &services.ConsensusMessageChunkInfo{
+			InitialTransactionID: &services.TransactionID{
+				AccountID:             &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1001}},
+				TransactionValidStart: _TimeToProtobuf(start),
+			},
+			Total:  2,
+			Number: number,
+		}
+	}
+
+	// A chunked message is interleaved with single messages
+	network.Mirror().AddTopicMessages(topicID,
+		&mirror.ConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(start.Add(1 * time.Second)), Message: []byte("hello "), SequenceNumber: 1, ChunkInfo: chunkInfo(1)},
+		&mirror.ConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(start.Add(2 * time.Second)), Message: []byte("single"), SequenceNumber: 2},
+		&mirror.ConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(start.Add(3 * time.Second)), Message: []byte("world"), SequenceNumber: 3, ChunkInfo: chunkInfo(2)},
+		&mirror.ConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(start.Add(4 * time.Second)), Message: []byte("last"), SequenceNumber: 4},
+	)
+
+	store, err := hiero.NewFileCheckpointStore(t.TempDir())
+	require.NoError(t, err)
+
+	// Each subscription stops after 2 messages of the mirror node, as if the process was restarted
+	subscribe := func() []hiero.TopicMessage {
+		var mutex sync.Mutex
+		received := make([]hiero.TopicMessage, 0)
+		done := make(chan struct{})
+
+		handle, err := hiero.NewTopicMessageQuery().
+			SetTopicID(topicID).
+			SetStartTime(time.Unix(0, 0)).
+			SetLimit(2).
+			SetCheckpointStore(store).
+			SetCheckpointErrorHandler(func(err error) {
+				t.Errorf("unexpected checkpoint error: %v", err)
+			}).
+			SetCompletionHandler(func() {
+				close(done)
+			}).
+			Subscribe(network.Client(), func(message hiero.TopicMessage) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				received = append(received, message)
+			})
+		require.NoError(t, err)
+		defer handle.Unsubscribe()
+
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("subscription did not complete")
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		return received
+	}
+
+	received := subscribe()
+	require.Len(t, received, 1)
+	require.Equal(t, []byte("single"), received[0].Contents)
+
+	// The resumed subscription starts after the delivered message and completes the chunked message received before
+	received = subscribe()
+	require.Len(t, received, 2)
+	require.Equal(t, []byte("hello world"), received[0].Contents)
+	require.Len(t, received[0].Chunks, 2)
+	require.Equal(t, []byte("last"), received[1].Contents)
+
+	subscriptions := network.Mirror().Subscriptions()
+	require.Len(t, subscriptions, 2)
+	require.Equal(t, _TimeToProtobuf(start.Add(2*time.Second+time.Nanosecond)).String(), subscriptions[1].ConsensusStartTime.String())
+
+	checkpoint, err := store.Load(topicID.String())
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	require.Equal(t, uint64(4), checkpoint.SequenceNumber)
+	require.Empty(t, checkpoint.PendingChunks)
+}
+
+func TestUnitNetworkTopicMessageQueryStream(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	topicID := hiero.TopicID{Topic: 1000}
+	for _, contents := range []string{"first", "second", "third"} {
+		network.Mirror().PublishTopicMessage(topicID, []byte(contents))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The stream closes once the limit is reached
+	results, err := hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetLimit(2).
+		Stream(ctx, network.Client())
+	require.NoError(t, err)
+
+	received := make([]string, 0)
+	for result := range results {
+		require.NoError(t, result.Err)
+		received = append(received, string(result.Message.Contents))
+	}
+	require.Equal(t, []string{"first", "second"}, received)
+
+	// Cancelling the context closes a stream without an end
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	results, err = hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetStreamBufferSize(1).
+		Stream(streamCtx, network.Client())
+	require.NoError(t, err)
+
+	result := <-results
+	require.NoError(t, result.Err)
+	require.Equal(t, []byte("first"), result.Message.Contents)
+	streamCancel()
+
+	for result := range results {
+		require.NoError(t, result.Err)
+	}
+	require.NoError(t, ctx.Err())
+}
+
+func TestUnitNetworkTopicMessageQueryStreamError(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	network.Mirror().EnqueueSubscriptionError(status.Error(codes.PermissionDenied, "denied"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	results, err := hiero.NewTopicMessageQuery().
+		SetTopicID(hiero.TopicID{Topic: 1000}).
+		SetErrorHandler(func(stat status.Status) {
+			t.Errorf("error handler called with %s", stat.Code())
+		}).
+		Stream(ctx, network.Client())
+	require.NoError(t, err)
+
+	// The error is the last result of the stream
+	result, ok := <-results
+	require.True(t, ok)
+	require.Equal(t, codes.PermissionDenied, status.Code(result.Err))
+	_, ok = <-results
+	require.False(t, ok)
+	require.NoError(t, ctx.Err())
+}
+
+func TestUnitNetworkTopicMessageQueryIncompleteMessage(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	topicID := hiero.TopicID{Topic: 1000}
+	start := time.Unix(1700000000, 0)
+	network.Mirror().AddTopicMessages(topicID,
+		&mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(start),
+			Message:            []byte("lost"),
+			SequenceNumber:     1,
+			ChunkInfo: &services.ConsensusMessageChunkInfo{
+				InitialTransactionID: &services.TransactionID{
+					AccountID:             &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1001}},
+					TransactionValidStart: _TimeToProtobuf(start),
+				},
+				Total:  2,
+				Number: 1,
+			},
+		},
+		&mirror.ConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(start.Add(time.Hour)), Message: []byte("later"), SequenceNumber: 2},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	results, err := hiero.NewTopicMessageQuery().
+		SetTopicID(topicID).
+		SetStartTime(time.Unix(0, 0)).
+		SetLimit(2).
+		SetChunkTimeout(time.Minute).
+		Stream(ctx, network.Client())
+	require.NoError(t, err)
+
+	// The chunked message times out when a message an hour later is received, and is reported before it
+	result := <-results
+	var incomplete hiero.ErrIncompleteTopicMessage
+	require.True(t, errors.As(result.Err, &incomplete))
+	require.Equal(t, hiero.IncompleteTopicMessageTimeout, incomplete.Reason)
+	require.Len(t, incomplete.Chunks, 1)
+
+	result = <-results
+	require.NoError(t, result.Err)
+	require.Equal(t, []byte("later"), result.Message.Contents)
+
+	_, ok := <-results
+	require.False(t, ok)
+}
+
+func TestUnitNetworkSubscriptionError(t *testing.T) {
+	t.Parallel()
+
+	network, err := NewNetwork()
+	require.NoError(t, err)
+	defer network.Close()
+
+	network.Mirror().EnqueueSubscriptionError(status.Error(codes.PermissionDenied, "denied"))
+
+	errs := make(chan codes.Code, 1)
+	_, err = hiero.NewTopicMessageQuery().
+		SetTopicID(hiero.TopicID{Topic: 1000}).
+		SetErrorHandler(func(stat status.Status) {
+			errs <- stat.Code()
+		}).
+		Subscribe(network.Client(), func(hiero.TopicMessage) {})
+	require.NoError(t, err)
+
+	select {
+	case code := <-errs:
+		require.Equal(t, codes.PermissionDenied, code)
+	case <-time.After(10 * time.Second):
+		t.Fatal("error handler was not called")
+	}
+}
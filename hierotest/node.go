@@ -0,0 +1,302 @@
+package hierotest
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Request is a request received by a Node.
+type Request struct {
+	// Method is the full gRPC method, e.g. services.CryptoService_CryptoTransfer_FullMethodName
+	Method string
+	// Transaction is the request of a transaction RPC, nil for queries
+	Transaction *services.Transaction
+	// Query is the request of a query RPC, nil for transactions
+	Query *services.Query
+}
+
+// TransactionBody decodes the body of a transaction request, or of the payment of a paid query.
+func (request Request) TransactionBody() (*services.TransactionBody, error) {
+	transaction := request.Transaction
+	if transaction == nil && request.Query != nil {
+		transaction = _QueryPayment(request.Query)
+	}
+	if transaction == nil {
+		return nil, fmt.Errorf("hierotest: %s request has no transaction", request.Method)
+	}
+
+	bodyBytes := transaction.GetBodyBytes()
+	if len(transaction.GetSignedTransactionBytes()) > 0 {
+		var signed services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), &signed); err != nil {
+			return nil, err
+		}
+		bodyBytes = signed.GetBodyBytes()
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// Node is a consensus node that answers with scripted responses.
+//
+// A response is one of:
+//   - *services.TransactionResponse or *services.Response, sent as is
+//   - error, returned as the gRPC error of the call; use status.Error to pick the code
+//   - func(*services.Transaction) *services.TransactionResponse, called with the request
+//   - func(*services.Query) *services.Response, called with the request
+//
+// Responses enqueued for a specific RPC with EnqueueFor are used first; otherwise the node answers with the next
+// response enqueued with Enqueue. When nothing is left, the call fails with codes.Aborted.
+type Node struct {
+	accountID hiero.AccountID
+	listener  net.Listener
+	server    *grpc.Server
+
+	mutex     sync.Mutex
+	responses []interface{}
+	byMethod  map[string][]interface{}
+	requests  []Request
+}
+
+func _NewNode(accountID hiero.AccountID) (*Node, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, err
+	}
+
+	node := &Node{
+		accountID: accountID,
+		listener:  listener,
+		server:    grpc.NewServer(),
+		byMethod:  make(map[string][]interface{}),
+	}
+
+	for _, service := range []*grpc.ServiceDesc{
+		&services.CryptoService_ServiceDesc,
+		&services.FileService_ServiceDesc,
+		&services.SmartContractService_ServiceDesc,
+		&services.ConsensusService_ServiceDesc,
+		&services.TokenService_ServiceDesc,
+		&services.ScheduleService_ServiceDesc,
+		&services.FreezeService_ServiceDesc,
+		&services.NetworkService_ServiceDesc,
+		&services.UtilService_ServiceDesc,
+		&services.AddressBookService_ServiceDesc,
+	} {
+		node.server.RegisterService(node._ServiceDescription(service), nil)
+	}
+
+	go func() {
+		_ = node.server.Serve(listener)
+	}()
+
+	return node, nil
+}
+
+// AccountID returns the account ID of the node.
+func (node *Node) AccountID() hiero.AccountID {
+	return node.accountID
+}
+
+// Address returns the host:port the node listens on.
+func (node *Node) Address() string {
+	return node.listener.Addr().String()
+}
+
+// Enqueue appends responses the node answers any RPC with, in order.
+func (node *Node) Enqueue(responses ...interface{}) *Node {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.responses = append(node.responses, responses...)
+	return node
+}
+
+// EnqueueFor appends responses the node answers the given RPC with, in order. method is the full gRPC method name,
+// e.g. services.CryptoService_CryptoGetBalance_FullMethodName.
+func (node *Node) EnqueueFor(method string, responses ...interface{}) *Node {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.byMethod[method] = append(node.byMethod[method], responses...)
+	return node
+}
+
+// Pending returns how many enqueued responses have not been used yet.
+func (node *Node) Pending() int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	pending := len(node.responses)
+	for _, responses := range node.byMethod {
+		pending += len(responses)
+	}
+
+	return pending
+}
+
+// Requests returns the requests the node received, in order.
+func (node *Node) Requests() []Request {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	return append([]Request(nil), node.requests...)
+}
+
+// RequestsFor returns the requests the node received for the given RPC, in order.
+func (node *Node) RequestsFor(method string) []Request {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	requests := make([]Request, 0)
+	for _, request := range node.requests {
+		if request.Method == method {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+// Reset drops all enqueued responses and recorded requests.
+func (node *Node) Reset() {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.responses = nil
+	node.byMethod = make(map[string][]interface{})
+	node.requests = nil
+}
+
+func (node *Node) close() {
+	node.server.Stop()
+}
+
+// _Next records request and pops the response for it
+func (node *Node) _Next(request Request) (interface{}, bool) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+
+	node.requests = append(node.requests, request)
+
+	if responses := node.byMethod[request.Method]; len(responses) > 0 {
+		node.byMethod[request.Method] = responses[1:]
+		return responses[0], true
+	}
+
+	if len(node.responses) > 0 {
+		response := node.responses[0]
+		node.responses = node.responses[1:]
+		return response, true
+	}
+
+	return nil, false
+}
+
+func (node *Node) _Handle(method string, isQuery bool, dec func(interface{}) error) (interface{}, error) {
+	request := Request{Method: method}
+	if isQuery {
+		request.Query = new(services.Query)
+		if err := dec(request.Query); err != nil {
+			return nil, err
+		}
+	} else {
+		request.Transaction = new(services.Transaction)
+		if err := dec(request.Transaction); err != nil {
+			return nil, err
+		}
+	}
+
+	response, ok := node._Next(request)
+	if !ok {
+		return nil, status.Errorf(codes.Aborted, "hierotest: node %s has no response for %s", node.accountID.String(), method)
+	}
+
+	switch response := response.(type) {
+	case error:
+		return nil, response
+	case func(*services.Transaction) *services.TransactionResponse:
+		if request.Transaction == nil {
+			return nil, status.Errorf(codes.Internal, "hierotest: transaction handler scripted for query %s", method)
+		}
+		return response(request.Transaction), nil
+	case func(*services.Query) *services.Response:
+		if request.Query == nil {
+			return nil, status.Errorf(codes.Internal, "hierotest: query handler scripted for transaction %s", method)
+		}
+		return response(request.Query), nil
+	default:
+		return response, nil
+	}
+}
+
+// _ServiceDescription replaces every handler of service with one answering from the node's script. Whether an RPC
+// takes a Transaction or a Query is read from the service's server interface.
+func (node *Node) _ServiceDescription(service *grpc.ServiceDesc) *grpc.ServiceDesc {
+	serverType := reflect.TypeOf(service.HandlerType).Elem()
+	queryType := reflect.TypeOf(&services.Query{})
+
+	methods := make([]grpc.MethodDesc, 0, len(service.Methods))
+	for _, desc := range service.Methods {
+		fullMethod := "/" + service.ServiceName + "/" + desc.MethodName
+
+		isQuery := false
+		if method, ok := serverType.MethodByName(strings.ToUpper(desc.MethodName[:1]) + desc.MethodName[1:]); ok {
+			isQuery = method.Type.NumIn() > 1 && method.Type.In(1) == queryType
+		}
+
+		methods = append(methods, grpc.MethodDesc{
+			MethodName: desc.MethodName,
+			Handler: func(_ interface{}, _ context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				return node._Handle(fullMethod, isQuery, dec)
+			},
+		})
+	}
+
+	return &grpc.ServiceDesc{
+		ServiceName: service.ServiceName,
+		HandlerType: service.HandlerType,
+		Methods:     methods,
+		Streams:     []grpc.StreamDesc{},
+		Metadata:    service.Metadata,
+	}
+}
+
+// _QueryPayment returns the payment transaction in the header of query, if any
+func _QueryPayment(query *services.Query) *services.Transaction {
+	message := query.ProtoReflect()
+	oneof := message.Descriptor().Oneofs().ByName("query")
+	if oneof == nil {
+		return nil
+	}
+
+	field := message.WhichOneof(oneof)
+	if field == nil {
+		return nil
+	}
+
+	header := message.Get(field).Message().Interface()
+	if withHeader, ok := header.(interface{ GetHeader() *services.QueryHeader }); ok {
+		return withHeader.GetHeader().GetPayment()
+	}
+
+	return nil
+}
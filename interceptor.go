@@ -0,0 +1,169 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Interceptor observes the execution of transactions and queries. Every callback is optional; nil callbacks are
+// skipped. Callbacks run synchronously on the executing goroutine, so they should return quickly.
+// Interceptors are registered with Client.AddInterceptor.
+type Interceptor struct {
+	// OnExecuteStart is called when the SDK starts executing a request. The returned context, if not nil, is used for
+	// the rest of the execution, including the gRPC calls and every following event. Interceptors registered later
+	// receive the context returned by earlier ones.
+	OnExecuteStart func(ExecuteEvent) context.Context
+	// OnExecuteEnd is called when the SDK finished executing a request, successfully or not.
+	OnExecuteEnd func(ExecuteEndEvent)
+	// OnAttempt is called right before a request is sent to a node.
+	OnAttempt func(AttemptEvent)
+	// OnResponse is called after a node answered, or the gRPC call failed.
+	OnResponse func(ResponseEvent)
+	// OnBackoff is called before the SDK waits ahead of the next attempt.
+	OnBackoff func(BackoffEvent)
+	// OnNodeUnhealthy is called when a node is marked unhealthy and temporarily taken out of rotation.
+	OnNodeUnhealthy func(NodeUnhealthyEvent)
+}
+
+// ExecuteEvent is passed to Interceptor.OnExecuteStart.
+type ExecuteEvent struct {
+	// Context is the context the request is being executed with
+	Context context.Context
+	// Name is the type of the request, e.g. "TransferTransaction" or "AccountBalanceQuery"
+	Name string
+	// RequestID is the identifier the SDK uses for the request in its logs
+	RequestID string
+	// TransactionID is the transaction ID of a transaction, or the payment transaction ID of a query
+	TransactionID string
+}
+
+// ExecuteEndEvent is passed to Interceptor.OnExecuteEnd.
+type ExecuteEndEvent struct {
+	ExecuteEvent
+	// Attempts is the number of attempts that were made
+	Attempts int64
+	// NodeAccountID is the node the last attempt was sent to
+	NodeAccountID AccountID
+	// Status is the last status a node answered with
+	Status Status
+	// QueryPayment is the payment attached to a query; it is zero for transactions and free queries
+	QueryPayment Hbar
+	// Duration is how long the execution took, including backoff
+	Duration time.Duration
+	// Err is the error the execution failed with, or nil
+	Err error
+}
+
+// RequestInfo identifies the request and attempt an interceptor event belongs to.
+type RequestInfo struct {
+	// Context is the context the request is being executed with
+	Context context.Context
+	// Name is the type of the request, e.g. "TransferTransaction" or "AccountBalanceQuery"
+	Name string
+	// RequestID is the identifier the SDK uses for the request in its logs
+	RequestID string
+	// TransactionID is the transaction ID of a transaction, or the payment transaction ID of a query
+	TransactionID string
+	// NodeAccountID is the node the attempt is sent to
+	NodeAccountID AccountID
+	// Attempt is the zero-based index of the attempt
+	Attempt int64
+}
+
+// AttemptEvent is passed to Interceptor.OnAttempt.
+type AttemptEvent struct {
+	RequestInfo
+	// Request is the *services.Transaction or *services.Query about to be sent
+	Request protobuf.Message
+}
+
+// ResponseEvent is passed to Interceptor.OnResponse.
+type ResponseEvent struct {
+	RequestInfo
+	// Err is the gRPC error of the call, or nil if the node answered
+	Err error
+	// Status is the status the node answered with when Err is nil
+	Status Status
+	// Latency is the duration of the gRPC call
+	Latency time.Duration
+	// Response is the *services.TransactionResponse or *services.Response the node answered with, or nil if Err is set
+	Response protobuf.Message
+}
+
+// BackoffEvent is passed to Interceptor.OnBackoff.
+type BackoffEvent struct {
+	RequestInfo
+	// Delay is how long the SDK is going to wait
+	Delay time.Duration
+	// Err is the failure that caused the backoff
+	Err error
+}
+
+// NodeUnhealthyEvent is passed to Interceptor.OnNodeUnhealthy.
+type NodeUnhealthyEvent struct {
+	RequestInfo
+	// Address is the address of the node
+	Address string
+	// ReadmitTime is when the node will be used again
+	ReadmitTime time.Time
+	// Err is the failure that caused the node to be marked unhealthy
+	Err error
+}
+
+type _Interceptors []Interceptor
+
+func (interceptors _Interceptors) _OnExecuteStart(event ExecuteEvent) context.Context {
+	for _, interceptor := range interceptors {
+		if interceptor.OnExecuteStart != nil {
+			if ctx := interceptor.OnExecuteStart(event); ctx != nil {
+				event.Context = ctx
+			}
+		}
+	}
+
+	return event.Context
+}
+
+func (interceptors _Interceptors) _OnExecuteEnd(event ExecuteEndEvent) {
+	for _, interceptor := range interceptors {
+		if interceptor.OnExecuteEnd != nil {
+			interceptor.OnExecuteEnd(event)
+		}
+	}
+}
+
+func (interceptors _Interceptors) _OnAttempt(event AttemptEvent) {
+	for _, interceptor := range interceptors {
+		if interceptor.OnAttempt != nil {
+			interceptor.OnAttempt(event)
+		}
+	}
+}
+
+func (interceptors _Interceptors) _OnResponse(event ResponseEvent) {
+	for _, interceptor := range interceptors {
+		if interceptor.OnResponse != nil {
+			interceptor.OnResponse(event)
+		}
+	}
+}
+
+func (interceptors _Interceptors) _OnBackoff(event BackoffEvent) {
+	for _, interceptor := range interceptors {
+		if interceptor.OnBackoff != nil {
+			interceptor.OnBackoff(event)
+		}
+	}
+}
+
+func (interceptors _Interceptors) _OnNodeUnhealthy(event NodeUnhealthyEvent) {
+	for _, interceptor := range interceptors {
+		if interceptor.OnNodeUnhealthy != nil {
+			interceptor.OnNodeUnhealthy(event)
+		}
+	}
+}
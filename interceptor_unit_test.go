@@ -0,0 +1,230 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type _InterceptorRecorder struct {
+	mutex     sync.Mutex
+	starts    []ExecuteEvent
+	ends      []ExecuteEndEvent
+	attempts  []AttemptEvent
+	responses []ResponseEvent
+	backoffs  []BackoffEvent
+	unhealthy []NodeUnhealthyEvent
+}
+
+func (recorder *_InterceptorRecorder) interceptor() Interceptor {
+	return Interceptor{
+		OnExecuteStart: func(event ExecuteEvent) context.Context {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+			recorder.starts = append(recorder.starts, event)
+			return nil
+		},
+		OnExecuteEnd: func(event ExecuteEndEvent) {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+			recorder.ends = append(recorder.ends, event)
+		},
+		OnAttempt: func(event AttemptEvent) {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+			recorder.attempts = append(recorder.attempts, event)
+		},
+		OnResponse: func(event ResponseEvent) {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+			recorder.responses = append(recorder.responses, event)
+		},
+		OnBackoff: func(event BackoffEvent) {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+			recorder.backoffs = append(recorder.backoffs, event)
+		},
+		OnNodeUnhealthy: func(event NodeUnhealthyEvent) {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+			recorder.unhealthy = append(recorder.unhealthy, event)
+		},
+	}
+}
+
+func TestUnitInterceptorTransactionRetry(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	recorder := &_InterceptorRecorder{}
+	client.AddInterceptor(recorder.interceptor())
+	// Interceptors with missing callbacks are skipped
+	client.AddInterceptor(Interceptor{})
+
+	resp, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		SetMinBackoff(time.Millisecond).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.attempts, 2)
+	require.Len(t, recorder.responses, 2)
+	require.Len(t, recorder.backoffs, 1)
+	require.Empty(t, recorder.unhealthy)
+
+	require.Equal(t, "FileCreateTransaction", recorder.attempts[0].Name)
+	require.Equal(t, resp.TransactionID.String(), recorder.attempts[0].TransactionID)
+	require.Equal(t, AccountID{Account: 3}, recorder.attempts[0].NodeAccountID)
+	require.Equal(t, int64(0), recorder.attempts[0].Attempt)
+	require.Equal(t, int64(1), recorder.attempts[1].Attempt)
+	require.IsType(t, &services.Transaction{}, recorder.attempts[0].Request)
+	require.NotNil(t, recorder.attempts[0].Context)
+
+	require.Equal(t, StatusBusy, recorder.responses[0].Status)
+	require.NoError(t, recorder.responses[0].Err)
+	require.IsType(t, &services.TransactionResponse{}, recorder.responses[0].Response)
+	require.Equal(t, StatusOk, recorder.responses[1].Status)
+
+	require.Equal(t, time.Millisecond, recorder.backoffs[0].Delay)
+	require.Equal(t, int64(0), recorder.backoffs[0].Attempt)
+	require.Error(t, recorder.backoffs[0].Err)
+}
+
+func TestUnitInterceptorNodeUnhealthy(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		status.New(codes.Unavailable, "node is down").Err(),
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	recorder := &_InterceptorRecorder{}
+	client.AddInterceptor(recorder.interceptor())
+
+	resp, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 3}, resp.NodeID)
+
+	require.Len(t, recorder.responses, 2)
+	require.Equal(t, codes.Unavailable, status.Code(recorder.responses[0].Err))
+	require.Nil(t, recorder.responses[0].Response)
+
+	require.Len(t, recorder.unhealthy, 1)
+	require.Equal(t, AccountID{Account: 3}, recorder.unhealthy[0].NodeAccountID)
+	require.NotEmpty(t, recorder.unhealthy[0].Address)
+	require.Equal(t, codes.Unavailable, status.Code(recorder.unhealthy[0].Err))
+}
+
+func TestUnitInterceptorQuery(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountID: &services.AccountID{ShardNum: 0, RealmNum: 0, Account: &services.AccountID_AccountNum{
+						AccountNum: 1800,
+					}},
+					Balance: 2000,
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	recorder := &_InterceptorRecorder{}
+	client.AddInterceptor(recorder.interceptor())
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.starts, 1)
+	require.Len(t, recorder.ends, 1)
+	require.Len(t, recorder.attempts, 1)
+	require.Equal(t, "AccountBalanceQuery", recorder.attempts[0].Name)
+	require.IsType(t, &services.Query{}, recorder.attempts[0].Request)
+	require.Len(t, recorder.responses, 1)
+	require.IsType(t, &services.Response{}, recorder.responses[0].Response)
+	require.Equal(t, StatusOk, recorder.responses[0].Status)
+}
+
+func TestUnitInterceptorPaidQuery(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptoGetAccountRecords{
+				CryptoGetAccountRecords: &services.CryptoGetAccountRecordsResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_COST_ANSWER, Cost: 2},
+					AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_CryptoGetAccountRecords{
+				CryptoGetAccountRecords: &services.CryptoGetAccountRecordsResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY, Cost: 2},
+					AccountID: &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	recorder := &_InterceptorRecorder{}
+	client.AddInterceptor(recorder.interceptor())
+
+	_, err := NewAccountRecordsQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetMaxQueryPayment(NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+
+	// The cost query and the query itself are two attempts of a single execution
+	require.Len(t, recorder.starts, 1)
+	require.Len(t, recorder.ends, 1)
+	require.Equal(t, "AccountRecordsQuery", recorder.ends[0].Name)
+	require.Equal(t, int64(2), recorder.ends[0].Attempts)
+	require.Equal(t, HbarFromTinybar(2), recorder.ends[0].QueryPayment)
+	require.Len(t, recorder.attempts, 2)
+}
@@ -0,0 +1,175 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"sort"
+)
+
+// KeySatisfaction reports how far a set of signatures goes towards satisfying a Key. For a KeyList, it has one child
+// per key of the list, so that the whole key tree can be inspected.
+//
+// A KeyList without a threshold requires all of its keys and a KeyList with a threshold requires that many of them.
+// An empty KeyList is never satisfied. Contract keys cannot be satisfied by signatures at all; they only count when
+// the contract itself is calling.
+type KeySatisfaction struct {
+	// Key is the evaluated key
+	Key Key
+	// Satisfied is true if the signatures satisfy the key
+	Satisfied bool
+	// Required is the number of child keys that must be satisfied; it is 1 for a single key
+	Required int
+	// SatisfiedCount is the number of child keys that are satisfied; for a single key it is 1 if it signed
+	SatisfiedCount int
+	// MissingSignatures is the smallest number of additional signatures that would satisfy the key, or -1 if more
+	// signatures can not satisfy it
+	MissingSignatures int
+	// Children are the evaluations of the keys of a KeyList, in the same order
+	Children []KeySatisfaction
+}
+
+// EvaluateKeySatisfaction evaluates whether the signatures of a transaction, as returned by GetSignatures, satisfy a
+// key. A public key only counts as signed if it signed the transaction for every node, since any of them may be the
+// one the transaction is submitted to. The signatures themselves are not verified.
+//
+// Transactions only apply signatures when they are built, so call ToBytes or Execute before GetSignatures.
+func EvaluateKeySatisfaction(key Key, signatures map[AccountID]map[*PublicKey][]byte) KeySatisfaction {
+	var signers map[string]bool
+
+	for _, nodeSignatures := range signatures {
+		nodeSigners := make(map[string]bool, len(nodeSignatures))
+		for publicKey := range nodeSignatures {
+			if publicKey != nil {
+				nodeSigners[string(publicKey.BytesRaw())] = true
+			}
+		}
+
+		if signers == nil {
+			signers = nodeSigners
+			continue
+		}
+
+		for signer := range signers {
+			if !nodeSigners[signer] {
+				delete(signers, signer)
+			}
+		}
+	}
+
+	return _EvaluateKey(key, func(publicKey PublicKey) bool {
+		return signers[string(publicKey.BytesRaw())]
+	})
+}
+
+// MissingKeys returns the single keys that have not signed, in the parts of the key tree that are not satisfied yet
+func (satisfaction KeySatisfaction) MissingKeys() []Key {
+	if satisfaction.Satisfied {
+		return []Key{}
+	}
+
+	if satisfaction.Children == nil {
+		return []Key{satisfaction.Key}
+	}
+
+	missing := make([]Key, 0)
+	for _, child := range satisfaction.Children {
+		missing = append(missing, child.MissingKeys()...)
+	}
+
+	return missing
+}
+
+// _EvaluateKey evaluates a key tree, using signed to decide whether a single public key is satisfied
+func _EvaluateKey(key Key, signed func(PublicKey) bool) KeySatisfaction {
+	switch k := key.(type) {
+	case PublicKey:
+		return _EvaluatePublicKey(k, signed)
+	case *PublicKey:
+		if k != nil {
+			return _EvaluatePublicKey(*k, signed)
+		}
+	case PrivateKey:
+		return _EvaluatePublicKey(k.PublicKey(), signed)
+	case *PrivateKey:
+		if k != nil {
+			return _EvaluatePublicKey(k.PublicKey(), signed)
+		}
+	case KeyList:
+		return _EvaluateKeyList(&k, signed)
+	case *KeyList:
+		if k != nil {
+			return _EvaluateKeyList(k, signed)
+		}
+	}
+
+	// Contract keys and unknown keys can't be satisfied by signatures
+	return KeySatisfaction{
+		Key:               key,
+		Required:          1,
+		MissingSignatures: -1,
+	}
+}
+
+func _EvaluatePublicKey(key PublicKey, signed func(PublicKey) bool) KeySatisfaction {
+	if signed(key) {
+		return KeySatisfaction{
+			Key:            key,
+			Satisfied:      true,
+			Required:       1,
+			SatisfiedCount: 1,
+		}
+	}
+
+	return KeySatisfaction{
+		Key:               key,
+		Required:          1,
+		MissingSignatures: 1,
+	}
+}
+
+func _EvaluateKeyList(keyList *KeyList, signed func(PublicKey) bool) KeySatisfaction {
+	satisfaction := KeySatisfaction{
+		Key:      keyList,
+		Required: len(keyList.keys),
+		Children: make([]KeySatisfaction, 0, len(keyList.keys)),
+	}
+	if keyList.threshold > 0 {
+		satisfaction.Required = keyList.threshold
+	}
+
+	// The cheapest unsatisfied children are the ones to sign next
+	missingSignatures := make([]int, 0)
+	for _, key := range keyList.keys {
+		child := _EvaluateKey(key, signed)
+		satisfaction.Children = append(satisfaction.Children, child)
+
+		if child.Satisfied {
+			satisfaction.SatisfiedCount++
+		} else if child.MissingSignatures >= 0 {
+			missingSignatures = append(missingSignatures, child.MissingSignatures)
+		}
+	}
+
+	if len(keyList.keys) == 0 {
+		satisfaction.MissingSignatures = -1
+		return satisfaction
+	}
+
+	missingChildren := satisfaction.Required - satisfaction.SatisfiedCount
+	if missingChildren <= 0 {
+		satisfaction.Satisfied = true
+		return satisfaction
+	}
+
+	if missingChildren > len(missingSignatures) {
+		satisfaction.MissingSignatures = -1
+		return satisfaction
+	}
+
+	sort.Ints(missingSignatures)
+	for _, count := range missingSignatures[:missingChildren] {
+		satisfaction.MissingSignatures += count
+	}
+
+	return satisfaction
+}
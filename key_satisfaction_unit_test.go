@@ -0,0 +1,139 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _SatisfactionTestKeys(t *testing.T, count int) []PrivateKey {
+	keys := make([]PrivateKey, 0, count)
+	for i := 0; i < count; i++ {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func _SignedTransferSignatures(t *testing.T, keys ...PrivateKey) map[AccountID]map[*PublicKey][]byte {
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 1801}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		transfer.Sign(key)
+	}
+
+	// Signatures are only applied once the transaction is built
+	_, err = transfer.ToBytes()
+	require.NoError(t, err)
+
+	signatures, err := transfer.GetSignatures()
+	require.NoError(t, err)
+
+	return signatures
+}
+
+func TestUnitEvaluateKeySatisfactionPublicKey(t *testing.T) {
+	t.Parallel()
+
+	keys := _SatisfactionTestKeys(t, 2)
+
+	satisfaction := EvaluateKeySatisfaction(keys[0].PublicKey(), _SignedTransferSignatures(t, keys[0]))
+	assert.True(t, satisfaction.Satisfied)
+	assert.Equal(t, 0, satisfaction.MissingSignatures)
+	assert.Empty(t, satisfaction.MissingKeys())
+
+	satisfaction = EvaluateKeySatisfaction(keys[0].PublicKey(), _SignedTransferSignatures(t, keys[1]))
+	assert.False(t, satisfaction.Satisfied)
+	assert.Equal(t, 1, satisfaction.MissingSignatures)
+	assert.Equal(t, []Key{keys[0].PublicKey()}, satisfaction.MissingKeys())
+}
+
+func TestUnitEvaluateKeySatisfactionThreshold(t *testing.T) {
+	t.Parallel()
+
+	keys := _SatisfactionTestKeys(t, 5)
+
+	// 2 of: keys[0], keys[1], and a list requiring all of keys[2], keys[3] and keys[4]
+	nested := NewKeyList().Add(keys[2].PublicKey()).Add(keys[3].PublicKey()).Add(keys[4].PublicKey())
+	key := KeyListWithThreshold(2).Add(keys[0].PublicKey()).Add(keys[1].PublicKey()).Add(nested)
+
+	satisfaction := EvaluateKeySatisfaction(key, _SignedTransferSignatures(t))
+	assert.False(t, satisfaction.Satisfied)
+	assert.Equal(t, 2, satisfaction.Required)
+	assert.Equal(t, 0, satisfaction.SatisfiedCount)
+	assert.Equal(t, 2, satisfaction.MissingSignatures)
+	require.Len(t, satisfaction.Children, 3)
+	assert.Equal(t, 3, satisfaction.Children[2].Required)
+	assert.Equal(t, 3, satisfaction.Children[2].MissingSignatures)
+
+	// Any two of keys[0], keys[1] and keys[4] would now satisfy the key
+	satisfaction = EvaluateKeySatisfaction(key, _SignedTransferSignatures(t, keys[2], keys[3]))
+	assert.False(t, satisfaction.Satisfied)
+	assert.Equal(t, 2, satisfaction.MissingSignatures)
+	assert.Equal(t, 2, satisfaction.Children[2].SatisfiedCount)
+	assert.Equal(t, 1, satisfaction.Children[2].MissingSignatures)
+	assert.Equal(t, []Key{keys[0].PublicKey(), keys[1].PublicKey(), keys[4].PublicKey()}, satisfaction.MissingKeys())
+
+	satisfaction = EvaluateKeySatisfaction(key, _SignedTransferSignatures(t, keys[1], keys[2], keys[3], keys[4]))
+	assert.True(t, satisfaction.Satisfied)
+	assert.Equal(t, 2, satisfaction.SatisfiedCount)
+	assert.Empty(t, satisfaction.MissingKeys())
+
+	// Keys read back from protobuf evaluate the same way
+	fromProtobuf, err := _KeyFromProtobuf(key._ToProtoKey())
+	require.NoError(t, err)
+	satisfaction = EvaluateKeySatisfaction(fromProtobuf, _SignedTransferSignatures(t, keys[0], keys[1]))
+	assert.True(t, satisfaction.Satisfied)
+}
+
+func TestUnitEvaluateKeySatisfactionUnsatisfiable(t *testing.T) {
+	t.Parallel()
+
+	keys := _SatisfactionTestKeys(t, 1)
+	signatures := _SignedTransferSignatures(t, keys[0])
+
+	satisfaction := EvaluateKeySatisfaction(ContractID{Contract: 5}, signatures)
+	assert.False(t, satisfaction.Satisfied)
+	assert.Equal(t, -1, satisfaction.MissingSignatures)
+
+	// The contract key can't be satisfied, so both keys of the list are needed
+	key := KeyListWithThreshold(1).Add(ContractID{Contract: 5}).Add(keys[0].PublicKey())
+	assert.True(t, EvaluateKeySatisfaction(key, signatures).Satisfied)
+	key = NewKeyList().Add(ContractID{Contract: 5}).Add(keys[0].PublicKey())
+	assert.Equal(t, -1, EvaluateKeySatisfaction(key, signatures).MissingSignatures)
+
+	assert.False(t, EvaluateKeySatisfaction(NewKeyList(), signatures).Satisfied)
+	assert.Equal(t, -1, EvaluateKeySatisfaction(KeyListWithThreshold(3).Add(keys[0].PublicKey()), nil).MissingSignatures)
+}
+
+func TestUnitEvaluateKeySatisfactionEveryNode(t *testing.T) {
+	t.Parallel()
+
+	keys := _SatisfactionTestKeys(t, 1)
+	signatures := _SignedTransferSignatures(t, keys[0])
+	require.Len(t, signatures, 2)
+
+	// A key that signed for only one of the nodes is not counted
+	for _, nodeSignatures := range signatures {
+		for publicKey := range nodeSignatures {
+			delete(nodeSignatures, publicKey)
+		}
+		break
+	}
+
+	assert.False(t, EvaluateKeySatisfaction(keys[0].PublicKey(), signatures).Satisfied)
+}
@@ -12,10 +12,14 @@ import (
 	"crypto/subtle"
 	"encoding/hex"
 	"encoding/json"
+	"strings"
 
 	"io"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
 )
 
 type _Keystore struct {
@@ -56,14 +60,72 @@ type _CryptoData struct {
 	Mac string `json:"mac"`
 }
 
+// internal struct used for Ethereum V3 keystores, as written by geth and MetaMask
+type _EthereumKeystore struct {
+	// hex-encoded address of the key, without the 0x prefix
+	Address string              `json:"address,omitempty"`
+	Crypto  _EthereumCryptoData `json:"crypto"`
+	ID      string              `json:"id"`
+	Version int                 `json:"version"`
+}
+
+// internal struct used for the kdf parameters of Ethereum V3 keystores; only the parameters of the KDF in use are set
+type _EthereumKdfParams struct {
+	// derived key length
+	DKLength int `json:"dklen"`
+	// hex-encoded salt
+	Salt string `json:"salt"`
+	// scrypt CPU/memory cost
+	N int `json:"n,omitempty"`
+	// scrypt block size
+	R int `json:"r,omitempty"`
+	// scrypt parallelization
+	P int `json:"p,omitempty"`
+	// pbkdf2 iteration count
+	Count int `json:"c,omitempty"`
+	// pbkdf2 hash function
+	PRF string `json:"prf,omitempty"`
+}
+
+// internal type used in _EthereumKeystore to represent the crypto data
+type _EthereumCryptoData struct {
+	// hex-encoded ciphertext
+	CipherText   string        `json:"ciphertext"`
+	CipherParams _CipherParams `json:"cipherparams"`
+	// Cipher being used
+	Cipher string `json:"cipher"`
+	// key derivation function being used
+	KDF string `json:"kdf"`
+	// parameters for key derivation function
+	KDFParams _EthereumKdfParams `json:"kdfparams"`
+	// hex-encoded Keccak-256 of the second half of the derived key and the ciphertext
+	Mac string `json:"mac"`
+}
+
 const Aes128Ctr = "aes-128-ctr"
 const HmacSha256 = "hmac-sha256"
 
+const kdfPbkdf2 = "pbkdf2"
+const kdfScrypt = "scrypt"
+
 // all values taken from https://github.com/ethereumjs/ethereumjs-wallet/blob/de3a92e752673ada1d78f95cf80bc56ae1f59775/src/index.ts#L25
 const dkLen int = 32
 const c int = 262144
 const saltLen uint = 32
 
+// scrypt parameters of geth's standard keystores
+const scryptN int = 1 << 18
+const scryptR int = 8
+const scryptP int = 1
+
+// Key derivation costs accepted when parsing keystores, so that a crafted keystore can't exhaust memory or CPU. The
+// scrypt memory cost is capped at that of geth's standard keystores, 256 MiB.
+const maxScryptN int = scryptN
+const maxScryptR int = scryptR
+const maxScryptP int = 16
+const maxPbkdf2Count int = 4 * c
+const maxKeystoreDKLength int = 64
+
 func _RandomBytes(n uint) ([]byte, error) {
 	// based on https://github.com/gophercon/2016-talks/tree/master/GeorgeTankersley-CryptoForGoDevelopers
 	b := make([]byte, n)
@@ -114,7 +176,7 @@ func _NewKeystore(privateKey []byte, passphrase string) ([]byte, error) {
 				IV: hex.EncodeToString(iv),
 			},
 			Cipher: Aes128Ctr,
-			KDF:    "pbkdf2",
+			KDF:    kdfPbkdf2,
 			KDFParams: _KdfParams{
 				DKLength: dkLen,
 				Salt:     hex.EncodeToString(salt),
@@ -128,7 +190,27 @@ func _NewKeystore(privateKey []byte, passphrase string) ([]byte, error) {
 	return json.Marshal(keystore)
 }
 
+// _ParseKeystore decrypts a Hedera (version 1) or an Ethereum V3 keystore
 func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error) {
+	version := struct {
+		Version int `json:"version"`
+	}{}
+
+	if err := json.Unmarshal(keystoreBytes, &version); err != nil {
+		return PrivateKey{}, err
+	}
+
+	switch version.Version {
+	case 1:
+		return _ParseHederaKeystore(keystoreBytes, passphrase)
+	case 3:
+		return _ParseEthereumKeystore(keystoreBytes, passphrase)
+	default:
+		return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore version: %v", version.Version)
+	}
+}
+
+func _ParseHederaKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error) {
 	keyStore := _Keystore{}
 
 	err := json.Unmarshal(keystoreBytes, &keyStore)
@@ -137,12 +219,7 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 		return PrivateKey{}, err
 	}
 
-	if keyStore.Version != 1 {
-		// todo: change to a switch and handle differently if future _Keystore versions are added
-		return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore version: %v", keyStore.Version)
-	}
-
-	if keyStore.Crypto.KDF != "pbkdf2" {
+	if keyStore.Crypto.KDF != kdfPbkdf2 {
 		return PrivateKey{}, _NewErrBadKeyf("unsupported KDF: %v", keyStore.Crypto.KDF)
 	}
 
@@ -156,6 +233,10 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 			keyStore.Crypto.KDFParams.PRF)
 	}
 
+	if count := keyStore.Crypto.KDFParams.Count; count < 1 || count > maxPbkdf2Count {
+		return PrivateKey{}, _NewErrBadKeyf("pbkdf2 iteration count must be between 1 and %v, got %v", maxPbkdf2Count, count)
+	}
+
 	salt, err := hex.DecodeString(keyStore.Crypto.KDFParams.Salt)
 
 	if err != nil {
@@ -168,6 +249,10 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 		return PrivateKey{}, err
 	}
 
+	if len(iv) != aes.BlockSize {
+		return PrivateKey{}, _NewErrBadKeyf("IV must be %v bytes, got %v", aes.BlockSize, len(iv))
+	}
+
 	cipherBytes, err := hex.DecodeString(keyStore.Crypto.CipherText)
 
 	if err != nil {
@@ -206,5 +291,172 @@ func _ParseKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error)
 
 	decipher.XORKeyStream(pkBytes, cipherBytes)
 
-	return PrivateKeyFromBytesEd25519(pkBytes)
+	// Ed25519 keys are stored raw, which older keystores rely on; ECDSA keys are stored DER encoded
+	switch len(pkBytes) {
+	case 32, 48, 64:
+		return PrivateKeyFromBytesEd25519(pkBytes)
+	default:
+		return PrivateKeyFromBytesDer(pkBytes)
+	}
+}
+
+func _NewEthereumKeystore(privateKey *_ECDSAPrivateKey, passphrase string, n int, p int) ([]byte, error) {
+	salt, err := _RandomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), salt, n, scryptR, p, dkLen)
+	if err != nil {
+		return nil, err
+	}
+
+	iv, err := _RandomBytes(16)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key[0:16])
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyBytes := privateKey._BytesRaw()
+	cipher := cipher2.NewCTR(block, iv)
+	cipherText := make([]byte, len(privateKeyBytes))
+	cipher.XORKeyStream(cipherText, privateKeyBytes)
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	keystore := _EthereumKeystore{
+		Address: privateKey._PublicKey()._ToEthereumAddress(),
+		Crypto: _EthereumCryptoData{
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: _CipherParams{
+				IV: hex.EncodeToString(iv),
+			},
+			Cipher: Aes128Ctr,
+			KDF:    kdfScrypt,
+			KDFParams: _EthereumKdfParams{
+				DKLength: dkLen,
+				Salt:     hex.EncodeToString(salt),
+				N:        n,
+				R:        scryptR,
+				P:        p,
+			},
+			Mac: hex.EncodeToString(_EthereumKeystoreMac(key, cipherText)),
+		},
+		ID:      id.String(),
+		Version: 3,
+	}
+
+	return json.Marshal(keystore)
+}
+
+func _ParseEthereumKeystore(keystoreBytes []byte, passphrase string) (PrivateKey, error) {
+	keyStore := _EthereumKeystore{}
+
+	if err := json.Unmarshal(keystoreBytes, &keyStore); err != nil {
+		return PrivateKey{}, err
+	}
+
+	if keyStore.Crypto.Cipher != Aes128Ctr {
+		return PrivateKey{}, _NewErrBadKeyf("unsupported _Keystore cipher: %v", keyStore.Crypto.Cipher)
+	}
+
+	if keyStore.Crypto.KDF != kdfScrypt && keyStore.Crypto.KDF != kdfPbkdf2 {
+		return PrivateKey{}, _NewErrBadKeyf("unsupported KDF: %v", keyStore.Crypto.KDF)
+	}
+
+	params := keyStore.Crypto.KDFParams
+	if params.DKLength < dkLen || params.DKLength > maxKeystoreDKLength {
+		return PrivateKey{}, _NewErrBadKeyf("derived key length must be between %v and %v bytes, got %v", dkLen, maxKeystoreDKLength, params.DKLength)
+	}
+
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	iv, err := hex.DecodeString(keyStore.Crypto.CipherParams.IV)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	if len(iv) != aes.BlockSize {
+		return PrivateKey{}, _NewErrBadKeyf("IV must be %v bytes, got %v", aes.BlockSize, len(iv))
+	}
+
+	cipherBytes, err := hex.DecodeString(keyStore.Crypto.CipherText)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	mac, err := hex.DecodeString(keyStore.Crypto.Mac)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	var key []byte
+	switch keyStore.Crypto.KDF {
+	case kdfScrypt:
+		if params.N > maxScryptN || params.R > maxScryptR || params.P > maxScryptP {
+			return PrivateKey{}, _NewErrBadKeyf(
+				"scrypt parameters n=%v, r=%v, p=%v exceed the maximum n=%v, r=%v, p=%v",
+				params.N, params.R, params.P, maxScryptN, maxScryptR, maxScryptP)
+		}
+		key, err = scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLength)
+		if err != nil {
+			return PrivateKey{}, _NewErrBadKeyf("invalid scrypt parameters: %v", err)
+		}
+	case kdfPbkdf2:
+		if params.PRF != HmacSha256 {
+			return PrivateKey{}, _NewErrBadKeyf("unsupported PRF: %v", params.PRF)
+		}
+		if params.Count < 1 || params.Count > maxPbkdf2Count {
+			return PrivateKey{}, _NewErrBadKeyf("pbkdf2 iteration count must be between 1 and %v, got %v", maxPbkdf2Count, params.Count)
+		}
+		key = pbkdf2.Key([]byte(passphrase), salt, params.Count, params.DKLength, sha256.New)
+	default:
+		return PrivateKey{}, _NewErrBadKeyf("unsupported KDF: %v", keyStore.Crypto.KDF)
+	}
+
+	if subtle.ConstantTimeCompare(mac, _EthereumKeystoreMac(key, cipherBytes)) == 0 {
+		return PrivateKey{}, _NewErrBadKeyf("mac mismatch; passphrase is incorrect")
+	}
+
+	block, err := aes.NewCipher(key[:16])
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	decipher := cipher2.NewCTR(block, iv)
+	pkBytes := make([]byte, len(cipherBytes))
+
+	decipher.XORKeyStream(pkBytes, cipherBytes)
+
+	privateKey, err := PrivateKeyFromBytesECDSA(pkBytes)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	if keyStore.Address != "" {
+		address := strings.TrimPrefix(strings.ToLower(keyStore.Address), "0x")
+		if address != privateKey.ecdsaPrivateKey._PublicKey()._ToEthereumAddress() {
+			return PrivateKey{}, _NewErrBadKeyf("keystore address %v does not match the decrypted key", keyStore.Address)
+		}
+	}
+
+	return privateKey, nil
+}
+
+// _EthereumKeystoreMac is the Keccak-256 of the second 16 bytes of the derived key followed by the ciphertext
+func _EthereumKeystoreMac(derivedKey []byte, cipherText []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(derivedKey[16:32])
+	hash.Write(cipherText)
+
+	return hash.Sum(nil)
 }
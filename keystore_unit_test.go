@@ -6,6 +6,8 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -46,3 +48,132 @@ func TestUnitEncryptAndDecryptKeyStore(t *testing.T) {
 
 	assert.Equal(t, privateKey.ed25519PrivateKey.keyData, ksPrivateKey.ed25519PrivateKey.keyData)
 }
+
+const testEthereumKeystoreKeyString string = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+
+// test vectors of the Web3 Secret Storage Definition
+const testEthereumKeystorePbkdf2 string = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+const testEthereumKeystoreScrypt string = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"p":8,"r":1,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+
+func TestUnitDecryptEthereumKeystore(t *testing.T) {
+	t.Parallel()
+
+	for _, keystore := range []string{testEthereumKeystorePbkdf2, testEthereumKeystoreScrypt} {
+		privateKey, err := PrivateKeyFromKeystore([]byte(keystore), "testpassword")
+		require.NoError(t, err)
+		assert.Equal(t, testEthereumKeystoreKeyString, privateKey.StringRaw())
+		assert.NotNil(t, privateKey.ecdsaPrivateKey)
+
+		_, err = PrivateKeyFromKeystore([]byte(keystore), "wrongpassword")
+		assert.EqualError(t, err, "mac mismatch; passphrase is incorrect")
+	}
+}
+
+func TestUnitEncryptAndDecryptEthereumKeystore(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	// geth's light scrypt parameters keep the test fast
+	keystore, err := _NewEthereumKeystore(privateKey.ecdsaPrivateKey, passphrase, 1<<12, 6)
+	require.NoError(t, err)
+
+	parsed := _EthereumKeystore{}
+	require.NoError(t, json.Unmarshal(keystore, &parsed))
+	assert.Equal(t, 3, parsed.Version)
+	assert.Equal(t, "scrypt", parsed.Crypto.KDF)
+	assert.Equal(t, privateKey.PublicKey().ToEvmAddress(), parsed.Address)
+	assert.NotEmpty(t, parsed.ID)
+
+	ksPrivateKey, err := PrivateKeyReadKeystore(bytes.NewReader(keystore), passphrase)
+	require.NoError(t, err)
+	assert.Equal(t, privateKey.StringRaw(), ksPrivateKey.StringRaw())
+
+	// The address must belong to the decrypted key
+	other, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	parsed.Address = "0x" + other.PublicKey().ToEvmAddress()
+	tampered, err := json.Marshal(parsed)
+	require.NoError(t, err)
+	_, err = PrivateKeyFromKeystore(tampered, passphrase)
+	assert.ErrorContains(t, err, "does not match the decrypted key")
+}
+
+func TestUnitEncryptAndDecryptKeystoreECDSA(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	var keystore bytes.Buffer
+	require.NoError(t, privateKey.WriteKeystore(&keystore, passphrase))
+
+	ksPrivateKey, err := PrivateKeyFromKeystore(keystore.Bytes(), passphrase)
+	require.NoError(t, err)
+	require.NotNil(t, ksPrivateKey.ecdsaPrivateKey)
+	assert.Equal(t, privateKey.StringRaw(), ksPrivateKey.StringRaw())
+}
+
+func TestUnitEthereumKeystoreErrors(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = privateKey.EthereumKeystore(passphrase)
+	assert.EqualError(t, err, "only ecdsa keys can be stored in an ethereum keystore")
+
+	_, err = PrivateKeyFromKeystore([]byte(`{"version":2}`), passphrase)
+	assert.EqualError(t, err, "unsupported _Keystore version: 2")
+
+	_, err = PrivateKeyFromKeystore([]byte(`{"version":3,"crypto":{"cipher":"aes-128-ctr","kdf":"argon2","kdfparams":{"dklen":32}}}`), passphrase)
+	assert.EqualError(t, err, "unsupported KDF: argon2")
+}
+
+func TestUnitKeystoreMalformed(t *testing.T) {
+	t.Parallel()
+
+	// _Mutate returns a keystore with a field of its crypto section replaced
+	_Mutate := func(keystore string, mutate func(crypto map[string]interface{})) []byte {
+		parsed := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(keystore), &parsed))
+		mutate(parsed["crypto"].(map[string]interface{}))
+		data, err := json.Marshal(parsed)
+		require.NoError(t, err)
+		return data
+	}
+	_SetIV := func(iv string) func(crypto map[string]interface{}) {
+		return func(crypto map[string]interface{}) {
+			crypto["cipherparams"].(map[string]interface{})["iv"] = iv
+		}
+	}
+	_SetKdfParam := func(name string, value interface{}) func(crypto map[string]interface{}) {
+		return func(crypto map[string]interface{}) {
+			crypto["kdfparams"].(map[string]interface{})[name] = value
+		}
+	}
+
+	privateKey, err := PrivateKeyFromString(testPrivateKeyStr)
+	require.NoError(t, err)
+	hederaKeystore, err := _NewKeystore(privateKey.Bytes(), passphrase)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		keystore []byte
+		err      string
+	}{
+		{_Mutate(testEthereumKeystorePbkdf2, _SetIV("6087dab2")), "IV must be 16 bytes, got 4"},
+		{_Mutate(testEthereumKeystoreScrypt, _SetIV("")), "IV must be 16 bytes, got 0"},
+		{_Mutate(string(hederaKeystore), _SetIV("6087dab2f9fdbbfaddc31a909735c1e600")), "IV must be 16 bytes, got 17"},
+		{_Mutate(testEthereumKeystoreScrypt, _SetKdfParam("n", 1<<30)), "scrypt parameters n=1073741824, r=1, p=8 exceed the maximum n=262144, r=8, p=16"},
+		{_Mutate(testEthereumKeystoreScrypt, _SetKdfParam("r", 1<<20)), "exceed the maximum"},
+		{_Mutate(testEthereumKeystoreScrypt, _SetKdfParam("p", 1<<20)), "exceed the maximum"},
+		{_Mutate(testEthereumKeystorePbkdf2, _SetKdfParam("c", 1<<30)), "pbkdf2 iteration count must be between 1 and 1048576, got 1073741824"},
+		{_Mutate(testEthereumKeystorePbkdf2, _SetKdfParam("c", 0)), "pbkdf2 iteration count must be between 1 and 1048576, got 0"},
+		{_Mutate(testEthereumKeystorePbkdf2, _SetKdfParam("dklen", 1<<30)), "derived key length must be between 32 and 64 bytes, got 1073741824"},
+		{_Mutate(string(hederaKeystore), _SetKdfParam("c", 1<<30)), "pbkdf2 iteration count must be between 1 and 1048576, got 1073741824"},
+	} {
+		_, err := PrivateKeyFromKeystore(test.keystore, "testpassword")
+		assert.ErrorContains(t, err, test.err)
+	}
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -61,7 +62,12 @@ func (q *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *LiveHashQuery) ExecuteWithContext(ctx context.Context, client *Client) (LiveHash, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return LiveHash{}, err
@@ -118,6 +124,12 @@ func (q *LiveHashQuery) SetMaxRetry(count int) *LiveHashQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this LiveHashQuery.
+func (q *LiveHashQuery) SetRetryPolicy(policy RetryPolicy) *LiveHashQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 func (q *LiveHashQuery) SetLogLevel(level LogLevel) *LiveHashQuery {
 	q.Query.SetLogLevel(level)
 	return q
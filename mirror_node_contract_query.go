@@ -2,6 +2,7 @@ package hiero
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -122,7 +123,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) GetBlockNumber() int64 {
 }
 
 // Returns gas estimation for the EVM execution
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Client) (uint64, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(ctx context.Context, client *Client) (uint64, error) {
 	err := mirrorNodeContractQuery.fillEvmAddresses()
 	if err != nil {
 		return 0, err
@@ -133,7 +134,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 		return 0, err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
+	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(ctx, client, jsonPayload)
 	if err != nil {
 		return 0, err
 	}
@@ -151,7 +152,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) estimateGas(client *Clie
 }
 
 // Does transient simulation of read-write operations and returns the result in hexadecimal string format. The result can be any solidity type.
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (string, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(ctx context.Context, client *Client) (string, error) {
 	err := mirrorNodeContractQuery.fillEvmAddresses()
 	if err != nil {
 		return "", err
@@ -168,7 +169,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) call(client *Client) (st
 		return "", err
 	}
 
-	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(client, jsonPayload)
+	result, err := mirrorNodeContractQuery.performContractCallToMirrorNode(ctx, client, jsonPayload)
 	if err != nil {
 		return "", err
 	}
@@ -199,7 +200,7 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) fillEvmAddresses() error
 	return nil
 }
 
-func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(client *Client, jsonPayload string) (map[string]any, error) {
+func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMirrorNode(ctx context.Context, client *Client, jsonPayload string) (map[string]any, error) {
 	if client.mirrorNetwork == nil || len(client.GetMirrorNetwork()) == 0 {
 		return nil, errors.New("mirror node is not set")
 	}
@@ -220,8 +221,17 @@ func (mirrorNodeContractQuery *mirrorNodeContractQuery) performContractCallToMir
 	}
 	url = fmt.Sprintf("%s://%s%s/api/v1/contracts/call", protocol, mirrorUrl, port)
 
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer([]byte(jsonPayload))) // #nosec
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer([]byte(jsonPayload)))
 	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req) // #nosec
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ErrContextDone{Err: ctx.Err()}
+		}
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 
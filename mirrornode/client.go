@@ -0,0 +1,208 @@
+// Package mirrornode is a client of the REST API of a mirror node, with typed responses for the core /api/v1
+// endpoints: accounts, balances, transactions, tokens and NFTs, topic messages, contract results and logs, schedules
+// and network information.
+//
+// List endpoints return a Pager, which follows the links.next of each page. Filters are set with Params:
+//
+//	client, err := mirrornode.NewClientFromNetwork(hieroClient)
+//	pager := client.ListTransactions(mirrornode.NewParams().
+//		Filter("account.id", mirrornode.OperatorEq, "0.0.1234").
+//		Filter("timestamp", mirrornode.OperatorGte, mirrornode.FormatTimestamp(since)).
+//		SetOrder(mirrornode.OrderDesc))
+//	for pager.HasNext() {
+//		transactions, err := pager.Next(ctx)
+//		...
+//	}
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+)
+
+// localRestPort is the port of the REST API of a mirror node of a local network
+const localRestPort = "5551"
+
+type config struct {
+	httpClient *http.Client
+}
+
+// Option configures a Client.
+type Option func(*config)
+
+// WithHTTPClient sets the HTTP client requests are sent with. http.DefaultClient is used by default.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *config) {
+		c.httpClient = httpClient
+	}
+}
+
+// Client sends requests to the REST API of mirror nodes. When a mirror node can't be reached or fails with a server
+// error, the request is sent to the next one.
+type Client struct {
+	httpClient *http.Client
+	baseURLs   []string
+
+	mutex   sync.Mutex
+	current int
+}
+
+// StatusError is returned when a mirror node answers with a status other than 200 OK.
+type StatusError struct {
+	StatusCode int
+	Messages   []string
+}
+
+func (err *StatusError) Error() string {
+	if len(err.Messages) == 0 {
+		return fmt.Sprintf("mirrornode: mirror node returned status %d", err.StatusCode)
+	}
+
+	return fmt.Sprintf("mirrornode: mirror node returned status %d: %s", err.StatusCode, strings.Join(err.Messages, "; "))
+}
+
+// NewClient creates a client of the mirror nodes at baseURLs, which are the scheme, host and port of each of them, for
+// example https://testnet.mirrornode.hedera.com.
+func NewClient(baseURLs []string, options ...Option) (*Client, error) {
+	if len(baseURLs) == 0 {
+		return nil, fmt.Errorf("mirrornode: at least one base URL is needed")
+	}
+
+	c := config{httpClient: http.DefaultClient}
+	for _, option := range options {
+		option(&c)
+	}
+
+	client := &Client{
+		httpClient: c.httpClient,
+		baseURLs:   make([]string, 0, len(baseURLs)),
+	}
+	for _, baseURL := range baseURLs {
+		parsed, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("mirrornode: invalid base URL %q: %w", baseURL, err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return nil, fmt.Errorf("mirrornode: base URL %q must be http or https", baseURL)
+		}
+
+		client.baseURLs = append(client.baseURLs, strings.TrimSuffix(baseURL, "/"))
+	}
+
+	return client, nil
+}
+
+// NewClientFromNetwork creates a client of the mirror network of a hiero.Client. Like the rest of the SDK, it uses
+// HTTPS on the default port, or HTTP on port 5551 for a local network, which has no ledger ID.
+func NewClientFromNetwork(client *hiero.Client, options ...Option) (*Client, error) {
+	mirrorNetwork := client.GetMirrorNetwork()
+	if len(mirrorNetwork) == 0 {
+		return nil, fmt.Errorf("mirrornode: the client has no mirror network")
+	}
+
+	local := client.GetLedgerID() == nil || client.GetLedgerID().String() == ""
+
+	baseURLs := make([]string, 0, len(mirrorNetwork))
+	for _, address := range mirrorNetwork {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("mirrornode: invalid mirror node address %q: %w", address, err)
+		}
+
+		if local {
+			baseURLs = append(baseURLs, "http://"+net.JoinHostPort(host, localRestPort))
+		} else {
+			baseURLs = append(baseURLs, "https://"+host)
+		}
+	}
+
+	return NewClient(baseURLs, options...)
+}
+
+// BaseURLs returns the base URLs of the mirror nodes of the client.
+func (client *Client) BaseURLs() []string {
+	return append([]string(nil), client.baseURLs...)
+}
+
+// _Get decodes the JSON response to the request of path, which starts with /api/v1, into result.
+func (client *Client) _Get(ctx context.Context, path string, result any) error {
+	client.mutex.Lock()
+	start := client.current
+	client.mutex.Unlock()
+
+	var err error
+	for attempt := 0; attempt < len(client.baseURLs); attempt++ {
+		index := (start + attempt) % len(client.baseURLs)
+		var retryable bool
+		retryable, err = client._GetFrom(ctx, client.baseURLs[index]+path, result)
+		if err == nil || !retryable || ctx.Err() != nil {
+			return err
+		}
+
+		client.mutex.Lock()
+		client.current = (index + 1) % len(client.baseURLs)
+		client.mutex.Unlock()
+	}
+
+	return err
+}
+
+// _GetFrom sends a request to a mirror node. It also reports whether the request may succeed on another mirror node
+// if it failed: when this one can't be reached, fails with a server error or is rate limited.
+func (client *Client) _GetFrom(ctx context.Context, requestURL string, result any) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return false, fmt.Errorf("mirrornode: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return true, fmt.Errorf("mirrornode: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests, _StatusErrorOf(response)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return false, fmt.Errorf("mirrornode: failed to decode the response of %s: %w", request.URL.Path, err)
+	}
+
+	return false, nil
+}
+
+// _StatusErrorOf reads the messages of an error response, which look like {"_status":{"messages":[{"message":"..."}]}}
+func _StatusErrorOf(response *http.Response) *StatusError {
+	statusError := &StatusError{StatusCode: response.StatusCode}
+
+	var body struct {
+		Status struct {
+			Messages []struct {
+				Message string `json:"message"`
+			} `json:"messages"`
+		} `json:"_status"`
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, 1<<16))
+	if err != nil || json.Unmarshal(data, &body) != nil {
+		return statusError
+	}
+
+	for _, message := range body.Status.Messages {
+		statusError.Messages = append(statusError.Messages, message.Message)
+	}
+
+	return statusError
+}
@@ -0,0 +1,192 @@
+//go:build all || unit
+// +build all unit
+
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestServer(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient([]string{server.URL})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestUnitMirrorNodeClientPagination(t *testing.T) {
+	t.Parallel()
+
+	queries := make([]string, 0)
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/accounts", r.URL.Path)
+		queries = append(queries, r.URL.RawQuery)
+
+		if r.URL.Query().Get("account.id") == "gt:0.0.100" && len(r.URL.Query()["account.id"]) == 1 {
+			_, _ = w.Write([]byte(`{"accounts":[{"account":"0.0.101"},{"account":"0.0.102"}],"links":{"next":"/api/v1/accounts?account.id=lte:0.0.200&account.id=gt:0.0.102&limit=2&order=asc"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"accounts":[{"account":"0.0.103"}],"links":{"next":null}}`))
+	})
+
+	pager := client.ListAccounts(NewParams().
+		Filter("account.id", OperatorGt, "0.0.100").
+		SetOrder(OrderAsc).
+		SetLimit(2))
+
+	require.True(t, pager.HasNext())
+	accounts, err := pager.Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "0.0.101", accounts[0].Account)
+	assert.Equal(t, "0.0.102", accounts[1].Account)
+	require.True(t, pager.HasNext())
+
+	accounts, err = pager.All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "0.0.103", accounts[0].Account)
+	require.False(t, pager.HasNext())
+
+	_, err = pager.Next(context.Background())
+	require.Error(t, err)
+
+	assert.Equal(t, []string{
+		"account.id=gt%3A0.0.100&limit=2&order=asc",
+		"account.id=lte:0.0.200&account.id=gt:0.0.102&limit=2&order=asc",
+	}, queries)
+}
+
+func TestUnitMirrorNodeClientNextLinkMustBePath(t *testing.T) {
+	t.Parallel()
+
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"balances":[],"links":{"next":"https://example.com/api/v1/balances?account.id=gt:0.0.2"}}`))
+	})
+
+	pager := client.ListBalances(nil)
+	_, err := pager.Next(context.Background())
+	require.ErrorContains(t, err, "is not a path")
+	require.True(t, pager.HasNext())
+}
+
+func TestUnitMirrorNodeClientFailover(t *testing.T) {
+	t.Parallel()
+
+	failing := 0
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failing++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failingServer.Close)
+
+	succeeding := 0
+	succeedingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		succeeding++
+		_, _ = w.Write([]byte(`{"released_supply":"3999999999999999949","timestamp":"1700000000.000000001","total_supply":"5000000000000000000"}`))
+	}))
+	t.Cleanup(succeedingServer.Close)
+
+	client, err := NewClient([]string{failingServer.URL, succeedingServer.URL + "/"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{failingServer.URL, succeedingServer.URL}, client.BaseURLs())
+
+	supply, err := client.GetNetworkSupply(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "5000000000000000000", supply.TotalSupply)
+
+	// The mirror node that answered is tried first afterwards
+	_, err = client.GetNetworkSupply(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, failing)
+	assert.Equal(t, 2, succeeding)
+}
+
+func TestUnitMirrorNodeClientStatusError(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"_status":{"messages":[{"message":"Not found"}]}}`))
+	})
+
+	_, err := client.GetAccount(context.Background(), "0.0.404")
+	require.Error(t, err)
+
+	var statusError *StatusError
+	require.True(t, errors.As(err, &statusError))
+	assert.Equal(t, http.StatusNotFound, statusError.StatusCode)
+	assert.Equal(t, []string{"Not found"}, statusError.Messages)
+	assert.Equal(t, "mirrornode: mirror node returned status 404: Not found", err.Error())
+	assert.Equal(t, 1, requests)
+}
+
+func TestUnitMirrorNodeClientInvalidBaseURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(nil)
+	require.Error(t, err)
+
+	_, err = NewClient([]string{"testnet.mirrornode.hedera.com:443"})
+	require.Error(t, err)
+
+	_, err = NewClient([]string{"ftp://testnet.mirrornode.hedera.com"})
+	require.Error(t, err)
+}
+
+func TestUnitMirrorNodeClientFromNetwork(t *testing.T) {
+	t.Parallel()
+
+	testnet := hiero.ClientForTestnet()
+	client, err := NewClientFromNetwork(testnet)
+	require.NoError(t, err)
+	for _, baseURL := range client.BaseURLs() {
+		assert.Regexp(t, `^https://[^:]+$`, baseURL)
+	}
+
+	local := hiero.ClientForNetwork(map[string]hiero.AccountID{"127.0.0.1:50211": {Account: 3}})
+	local.SetMirrorNetwork([]string{"127.0.0.1:5600"})
+	client, err = NewClientFromNetwork(local)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://127.0.0.1:5551"}, client.BaseURLs())
+}
+
+func TestUnitMirrorNodeTimestamp(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Unix(1700000000, 1)
+	assert.Equal(t, "1700000000.000000001", FormatTimestamp(timestamp))
+
+	parsed, err := Timestamp("1700000000.000000001").Time()
+	require.NoError(t, err)
+	assert.True(t, timestamp.Equal(parsed))
+
+	parsed, err = Timestamp("1700000000.5").Time()
+	require.NoError(t, err)
+	assert.Equal(t, 500000000, parsed.Nanosecond())
+
+	_, err = Timestamp("").Time()
+	require.Error(t, err)
+
+	_, err = Timestamp("1700000000.0000000001").Time()
+	require.Error(t, err)
+}
@@ -0,0 +1,189 @@
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+)
+
+// ListAccounts lists the accounts, filtered for example by account.id, account.balance or account.publickey.
+func (client *Client) ListAccounts(params *Params) *Pager[Account] {
+	return _NewPager[Account](client, "accounts", params._Path("/api/v1/accounts"))
+}
+
+// GetAccount returns an account from its ID, alias or EVM address.
+func (client *Client) GetAccount(ctx context.Context, idOrAliasOrEvmAddress string) (*Account, error) {
+	var account Account
+	if err := client._Get(ctx, "/api/v1/accounts/"+url.PathEscape(idOrAliasOrEvmAddress), &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// ListAccountNfts lists the NFTs owned by an account, filtered for example by token.id or serialnumber.
+func (client *Client) ListAccountNfts(idOrAliasOrEvmAddress string, params *Params) *Pager[Nft] {
+	return _NewPager[Nft](client, "nfts", params._Path("/api/v1/accounts/"+url.PathEscape(idOrAliasOrEvmAddress)+"/nfts"))
+}
+
+// ListBalances lists the balances of the accounts, filtered for example by account.id, account.balance or timestamp.
+func (client *Client) ListBalances(params *Params) *Pager[AccountBalance] {
+	return _NewPager[AccountBalance](client, "balances", params._Path("/api/v1/balances"))
+}
+
+// ListTransactions lists the transactions, filtered for example by account.id, timestamp, transactiontype or result.
+func (client *Client) ListTransactions(params *Params) *Pager[Transaction] {
+	return _NewPager[Transaction](client, "transactions", params._Path("/api/v1/transactions"))
+}
+
+// GetTransaction returns the transactions with a transaction ID: the transaction itself and the child transactions
+// it caused. The nonce and scheduled flag of the ID, when set, select a single one of them.
+func (client *Client) GetTransaction(ctx context.Context, transactionID hiero.TransactionID) ([]Transaction, error) {
+	if transactionID.AccountID == nil || transactionID.ValidStart == nil {
+		return nil, fmt.Errorf("mirrornode: the transaction ID needs an account ID and a valid start")
+	}
+
+	params := NewParams()
+	if transactionID.Nonce != nil {
+		params.Set("nonce", strconv.FormatInt(int64(*transactionID.Nonce), 10))
+	}
+	if transactionID.GetScheduled() {
+		params.Set("scheduled", "true")
+	}
+
+	var page struct {
+		Transactions []Transaction `json:"transactions"`
+	}
+	path := "/api/v1/transactions/" + url.PathEscape(_FormatTransactionID(transactionID))
+	if err := client._Get(ctx, params._Path(path), &page); err != nil {
+		return nil, err
+	}
+
+	return page.Transactions, nil
+}
+
+// ListTokens lists the tokens, filtered for example by token.id, type or account.id of the associated accounts.
+func (client *Client) ListTokens(params *Params) *Pager[Token] {
+	return _NewPager[Token](client, "tokens", params._Path("/api/v1/tokens"))
+}
+
+// GetToken returns the detail of a token.
+func (client *Client) GetToken(ctx context.Context, tokenID hiero.TokenID) (*TokenInfo, error) {
+	var token TokenInfo
+	if err := client._Get(ctx, "/api/v1/tokens/"+url.PathEscape(tokenID.String()), &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// ListNfts lists the NFTs of a token, filtered for example by account.id or serialnumber.
+func (client *Client) ListNfts(tokenID hiero.TokenID, params *Params) *Pager[Nft] {
+	return _NewPager[Nft](client, "nfts", params._Path("/api/v1/tokens/"+url.PathEscape(tokenID.String())+"/nfts"))
+}
+
+// GetNft returns an NFT of a token.
+func (client *Client) GetNft(ctx context.Context, tokenID hiero.TokenID, serialNumber int64) (*Nft, error) {
+	var nft Nft
+	path := "/api/v1/tokens/" + url.PathEscape(tokenID.String()) + "/nfts/" + strconv.FormatInt(serialNumber, 10)
+	if err := client._Get(ctx, path, &nft); err != nil {
+		return nil, err
+	}
+
+	return &nft, nil
+}
+
+// ListTopicMessages lists the messages of a topic, filtered for example by sequencenumber or timestamp.
+func (client *Client) ListTopicMessages(topicID hiero.TopicID, params *Params) *Pager[TopicMessage] {
+	return _NewPager[TopicMessage](client, "messages", params._Path("/api/v1/topics/"+url.PathEscape(topicID.String())+"/messages"))
+}
+
+// GetTopicMessage returns a message of a topic from its sequence number.
+func (client *Client) GetTopicMessage(ctx context.Context, topicID hiero.TopicID, sequenceNumber uint64) (*TopicMessage, error) {
+	var message TopicMessage
+	path := "/api/v1/topics/" + url.PathEscape(topicID.String()) + "/messages/" + strconv.FormatUint(sequenceNumber, 10)
+	if err := client._Get(ctx, path, &message); err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+// ListContractResults lists the results of the calls to a contract, from its ID or EVM address, filtered for example
+// by timestamp or from.
+func (client *Client) ListContractResults(idOrEvmAddress string, params *Params) *Pager[ContractResult] {
+	return _NewPager[ContractResult](client, "results", params._Path("/api/v1/contracts/"+url.PathEscape(idOrEvmAddress)+"/results"))
+}
+
+// GetContractResult returns the result of a contract call or creation from its transaction ID, formatted like
+// 0.0.2-1700000000-000000001, or its Ethereum transaction hash.
+func (client *Client) GetContractResult(ctx context.Context, transactionIDOrHash string) (*ContractResult, error) {
+	var result ContractResult
+	if err := client._Get(ctx, "/api/v1/contracts/results/"+url.PathEscape(transactionIDOrHash), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListContractLogs lists the logs emitted by a contract, from its ID or EVM address, filtered for example by timestamp
+// or topic0.
+func (client *Client) ListContractLogs(idOrEvmAddress string, params *Params) *Pager[ContractLog] {
+	return _NewPager[ContractLog](client, "logs", params._Path("/api/v1/contracts/"+url.PathEscape(idOrEvmAddress)+"/results/logs"))
+}
+
+// ListSchedules lists the scheduled transactions, filtered for example by schedule.id or account.id of the creator.
+func (client *Client) ListSchedules(params *Params) *Pager[Schedule] {
+	return _NewPager[Schedule](client, "schedules", params._Path("/api/v1/schedules"))
+}
+
+// GetSchedule returns a scheduled transaction.
+func (client *Client) GetSchedule(ctx context.Context, scheduleID hiero.ScheduleID) (*Schedule, error) {
+	var schedule Schedule
+	if err := client._Get(ctx, "/api/v1/schedules/"+url.PathEscape(scheduleID.String()), &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// GetNetworkSupply returns the supply of hbars.
+func (client *Client) GetNetworkSupply(ctx context.Context) (*NetworkSupply, error) {
+	var supply NetworkSupply
+	if err := client._Get(ctx, "/api/v1/network/supply", &supply); err != nil {
+		return nil, err
+	}
+
+	return &supply, nil
+}
+
+// GetNetworkFees returns the gas prices of the transaction types.
+func (client *Client) GetNetworkFees(ctx context.Context) (*NetworkFees, error) {
+	var fees NetworkFees
+	if err := client._Get(ctx, "/api/v1/network/fees", &fees); err != nil {
+		return nil, err
+	}
+
+	return &fees, nil
+}
+
+// GetExchangeRate returns the current and next exchange rate between hbars and cents of USD.
+func (client *Client) GetExchangeRate(ctx context.Context) (*ExchangeRate, error) {
+	var rate ExchangeRate
+	if err := client._Get(ctx, "/api/v1/network/exchangerate", &rate); err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+// _FormatTransactionID formats a transaction ID like the mirror node, 0.0.2-1700000000-000000001
+func _FormatTransactionID(transactionID hiero.TransactionID) string {
+	validStart := *transactionID.ValidStart
+	return fmt.Sprintf("%s-%d-%09d", transactionID.AccountID.String(), validStart.Unix(), validStart.Nanosecond())
+}
@@ -0,0 +1,158 @@
+//go:build all || unit
+// +build all unit
+
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitMirrorNodeTopicMessages(t *testing.T) {
+	t.Parallel()
+
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/topics/0.0.1234/messages", r.URL.Path)
+		require.Equal(t, "gte:10", r.URL.Query().Get("sequencenumber"))
+		require.Equal(t, "desc", r.URL.Query().Get("order"))
+
+		_, _ = w.Write([]byte(`{"messages":[{
+			"chunk_info":{"initial_transaction_id":{"account_id":"0.0.2","nonce":0,"scheduled":false,"transaction_valid_start":"1700000000.000000001"},"number":1,"total":2},
+			"consensus_timestamp":"1700000001.000000002",
+			"message":"aGVsbG8=",
+			"payer_account_id":"0.0.2",
+			"running_hash":"AQID",
+			"running_hash_version":3,
+			"sequence_number":10,
+			"topic_id":"0.0.1234"
+		}],"links":{"next":null}}`))
+	})
+
+	messages, err := client.ListTopicMessages(hiero.TopicID{Topic: 1234}, NewParams().
+		Filter("sequencenumber", OperatorGte, "10").
+		SetOrder(OrderDesc)).All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	message := messages[0]
+	assert.Equal(t, []byte("hello"), message.Message)
+	assert.Equal(t, []byte{1, 2, 3}, message.RunningHash)
+	assert.Equal(t, uint64(10), message.SequenceNumber)
+	require.NotNil(t, message.ChunkInfo)
+	assert.Equal(t, int32(2), message.ChunkInfo.Total)
+	assert.Equal(t, "0.0.2", message.ChunkInfo.InitialTransactionID.AccountID)
+
+	consensusTimestamp, err := message.ConsensusTimestamp.Time()
+	require.NoError(t, err)
+	assert.True(t, time.Unix(1700000001, 2).Equal(consensusTimestamp))
+}
+
+func TestUnitMirrorNodeToken(t *testing.T) {
+	t.Parallel()
+
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/tokens/0.0.5678":
+			_, _ = w.Write([]byte(`{"token_id":"0.0.5678","symbol":"FT","name":"Fungible","type":"FUNGIBLE_COMMON","decimals":"8","total_supply":"100000000000","max_supply":"0","supply_type":"INFINITE","treasury_account_id":"0.0.2","admin_key":{"_type":"ED25519","key":"0123"},"freeze_key":null}`))
+		case "/api/v1/tokens/0.0.5678/nfts/3":
+			_, _ = w.Write([]byte(`{"account_id":"0.0.2","metadata":"bWV0YQ==","serial_number":3,"token_id":"0.0.5678","deleted":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tokenID := hiero.TokenID{Token: 5678}
+
+	token, err := client.GetToken(context.Background(), tokenID)
+	require.NoError(t, err)
+	assert.Equal(t, "8", token.Decimals)
+	assert.Equal(t, "100000000000", token.TotalSupply)
+	require.NotNil(t, token.AdminKey)
+	assert.Equal(t, "ED25519", token.AdminKey.Type)
+	assert.Nil(t, token.FreezeKey)
+
+	nft, err := client.GetNft(context.Background(), tokenID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("meta"), nft.Metadata)
+	assert.Equal(t, int64(3), nft.SerialNumber)
+}
+
+func TestUnitMirrorNodeGetTransaction(t *testing.T) {
+	t.Parallel()
+
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/transactions/0.0.2-1700000000-000000001", r.URL.Path)
+		require.Equal(t, "1", r.URL.Query().Get("nonce"))
+		require.Equal(t, "true", r.URL.Query().Get("scheduled"))
+
+		_, _ = w.Write([]byte(`{"transactions":[{"transaction_id":"0.0.2-1700000000-000000001","name":"CRYPTOTRANSFER","result":"SUCCESS","memo_base64":"bWVtbw==","transfers":[{"account":"0.0.2","amount":-10,"is_approval":false},{"account":"0.0.3","amount":10,"is_approval":false}]}]}`))
+	})
+
+	transactionID := hiero.NewTransactionIDWithValidStart(hiero.AccountID{Account: 2}, time.Unix(1700000000, 1)).
+		SetNonce(1).
+		SetScheduled(true)
+
+	transactions, err := client.GetTransaction(context.Background(), transactionID)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, []byte("memo"), transactions[0].Memo)
+	require.Len(t, transactions[0].Transfers, 2)
+	assert.Equal(t, int64(10), transactions[0].Transfers[1].Amount)
+
+	_, err = client.GetTransaction(context.Background(), hiero.TransactionID{})
+	require.Error(t, err)
+}
+
+func TestUnitMirrorNodeNetwork(t *testing.T) {
+	t.Parallel()
+
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/network/fees":
+			_, _ = w.Write([]byte(`{"fees":[{"gas":82,"transaction_type":"ContractCall"}],"timestamp":"1700000000.000000001"}`))
+		case "/api/v1/network/exchangerate":
+			_, _ = w.Write([]byte(`{"current_rate":{"cent_equivalent":12,"expiration_time":1700003600,"hbar_equivalent":1},"next_rate":{"cent_equivalent":15,"expiration_time":1700007200,"hbar_equivalent":1},"timestamp":"1700000000.000000001"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	fees, err := client.GetNetworkFees(context.Background())
+	require.NoError(t, err)
+	require.Len(t, fees.Fees, 1)
+	assert.Equal(t, int64(82), fees.Fees[0].Gas)
+	assert.Equal(t, "ContractCall", fees.Fees[0].TransactionType)
+
+	rate, err := client.GetExchangeRate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), rate.CurrentRate.CentEquivalent)
+	assert.Equal(t, int32(15), rate.NextRate.CentEquivalent)
+	assert.Equal(t, int64(1700007200), rate.NextRate.ExpirationTime)
+}
+
+func TestUnitMirrorNodeContractLogs(t *testing.T) {
+	t.Parallel()
+
+	client := _TestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/contracts/0x00000000000000000000000000000000000004d2/results/logs", r.URL.Path)
+		require.Equal(t, []string{"gte:1700000000.000000000", "lte:1700000060.000000000"}, r.URL.Query()["timestamp"])
+
+		_, _ = w.Write([]byte(`{"logs":[{"address":"0x00000000000000000000000000000000000004d2","contract_id":"0.0.1234","data":"0x01","index":0,"topics":["0xddf252ad"],"timestamp":"1700000001.000000000"}],"links":{"next":null}}`))
+	})
+
+	logs, err := client.ListContractLogs("0x00000000000000000000000000000000000004d2", NewParams().
+		Filter("timestamp", OperatorGte, FormatTimestamp(time.Unix(1700000000, 0))).
+		Filter("timestamp", OperatorLte, FormatTimestamp(time.Unix(1700000060, 0)))).All(context.Background())
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "0.0.1234", logs[0].ContractID)
+	assert.Equal(t, []string{"0xddf252ad"}, logs[0].Topics)
+}
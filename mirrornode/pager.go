@@ -0,0 +1,89 @@
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Pager iterates over the pages of results of a list endpoint, following the links.next of each page. A Pager is not
+// safe for concurrent use.
+type Pager[T any] struct {
+	client *Client
+	key    string
+	next   string
+	done   bool
+}
+
+// _Links are the links of a page of results
+type _Links struct {
+	Next *string `json:"next"`
+}
+
+func _NewPager[T any](client *Client, key string, path string) *Pager[T] {
+	return &Pager[T]{
+		client: client,
+		key:    key,
+		next:   path,
+	}
+}
+
+// HasNext reports whether there are more pages.
+func (pager *Pager[T]) HasNext() bool {
+	return !pager.done
+}
+
+// Next returns the next page of results. After an error, Next requests the same page again.
+func (pager *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if pager.done {
+		return nil, fmt.Errorf("mirrornode: there are no more pages")
+	}
+
+	var page map[string]json.RawMessage
+	if err := pager.client._Get(ctx, pager.next, &page); err != nil {
+		return nil, err
+	}
+
+	items := make([]T, 0)
+	if data, ok := page[pager.key]; ok {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("mirrornode: failed to decode the %s of %s: %w", pager.key, pager.next, err)
+		}
+	}
+
+	var links _Links
+	if data, ok := page["links"]; ok {
+		if err := json.Unmarshal(data, &links); err != nil {
+			return nil, fmt.Errorf("mirrornode: failed to decode the links of %s: %w", pager.next, err)
+		}
+	}
+
+	switch {
+	case links.Next == nil || *links.Next == "":
+		pager.done = true
+	case !strings.HasPrefix(*links.Next, "/"):
+		return nil, fmt.Errorf("mirrornode: the next link %q of %s is not a path", *links.Next, pager.next)
+	default:
+		pager.next = *links.Next
+	}
+
+	return items, nil
+}
+
+// All returns the results of every remaining page.
+func (pager *Pager[T]) All(ctx context.Context) ([]T, error) {
+	all := make([]T, 0)
+	for pager.HasNext() {
+		items, err := pager.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+	}
+
+	return all, nil
+}
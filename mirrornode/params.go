@@ -0,0 +1,90 @@
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Operator compares a query parameter of a list endpoint with a value.
+type Operator string
+
+const (
+	OperatorEq  Operator = "eq"
+	OperatorNe  Operator = "ne"
+	OperatorGt  Operator = "gt"
+	OperatorGte Operator = "gte"
+	OperatorLt  Operator = "lt"
+	OperatorLte Operator = "lte"
+)
+
+// Order is the order of the results of a list endpoint.
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Params are the query parameters of a list endpoint. A parameter can be filtered more than once, for example to select
+// a range of timestamps.
+type Params struct {
+	values url.Values
+}
+
+// NewParams creates empty Params, which leave the filters, order and page size to the mirror node.
+func NewParams() *Params {
+	return &Params{
+		values: url.Values{},
+	}
+}
+
+// Filter adds a filter on a parameter, such as account.id or timestamp.
+func (params *Params) Filter(name string, operator Operator, value string) *Params {
+	params.values.Add(name, string(operator)+":"+value)
+	return params
+}
+
+// Set sets a parameter without an operator, such as the type of transactions or the result of transactions.
+func (params *Params) Set(name string, value string) *Params {
+	params.values.Set(name, value)
+	return params
+}
+
+// SetOrder sets the order of the results.
+func (params *Params) SetOrder(order Order) *Params {
+	params.values.Set("order", string(order))
+	return params
+}
+
+// SetLimit sets the number of results per page. Mirror nodes cap it, usually at 100.
+func (params *Params) SetLimit(limit int) *Params {
+	params.values.Set("limit", strconv.Itoa(limit))
+	return params
+}
+
+// Encode returns the parameters as a URL query.
+func (params *Params) Encode() string {
+	if params == nil {
+		return ""
+	}
+
+	return params.values.Encode()
+}
+
+// _Path returns the path of an endpoint with the parameters as its query
+func (params *Params) _Path(path string) string {
+	if query := params.Encode(); query != "" {
+		return path + "?" + query
+	}
+
+	return path
+}
+
+// FormatTimestamp formats a time as a timestamp of the mirror node, seconds and nanoseconds since the epoch.
+func FormatTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
@@ -0,0 +1,323 @@
+package mirrornode
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp is a consensus timestamp of the mirror node, seconds and nanoseconds since the epoch such as
+// 1700000000.000000001.
+type Timestamp string
+
+// Time parses the timestamp.
+func (timestamp Timestamp) Time() (time.Time, error) {
+	seconds, nanos, _ := strings.Cut(string(timestamp), ".")
+	secondsValue, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("mirrornode: invalid timestamp %q", string(timestamp))
+	}
+
+	var nanosValue int64
+	if nanos != "" {
+		if len(nanos) > 9 {
+			return time.Time{}, fmt.Errorf("mirrornode: invalid timestamp %q", string(timestamp))
+		}
+		nanosValue, err = strconv.ParseInt(nanos+strings.Repeat("0", 9-len(nanos)), 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("mirrornode: invalid timestamp %q", string(timestamp))
+		}
+	}
+
+	return time.Unix(secondsValue, nanosValue), nil
+}
+
+// Key is a key of an entity, its protobuf type such as ED25519, ECDSA_SECP256K1 or ProtobufEncoded and its hex
+// encoding.
+type Key struct {
+	Type string `json:"_type"`
+	Key  string `json:"key"`
+}
+
+// TokenBalance is the balance of an account for a token.
+type TokenBalance struct {
+	TokenID string `json:"token_id"`
+	Balance int64  `json:"balance"`
+}
+
+// Balance is the hbar balance, in tinybars, and the token balances of an account at a timestamp.
+type Balance struct {
+	Timestamp Timestamp      `json:"timestamp"`
+	Balance   int64          `json:"balance"`
+	Tokens    []TokenBalance `json:"tokens"`
+}
+
+// Account is an account returned by /api/v1/accounts.
+type Account struct {
+	Account                       string    `json:"account"`
+	Alias                         *string   `json:"alias"`
+	AutoRenewPeriod               *int64    `json:"auto_renew_period"`
+	Balance                       *Balance  `json:"balance"`
+	CreatedTimestamp              Timestamp `json:"created_timestamp"`
+	DeclineReward                 bool      `json:"decline_reward"`
+	Deleted                       bool      `json:"deleted"`
+	EthereumNonce                 int64     `json:"ethereum_nonce"`
+	EvmAddress                    string    `json:"evm_address"`
+	ExpiryTimestamp               Timestamp `json:"expiry_timestamp"`
+	Key                           *Key      `json:"key"`
+	MaxAutomaticTokenAssociations int32     `json:"max_automatic_token_associations"`
+	Memo                          string    `json:"memo"`
+	PendingReward                 int64     `json:"pending_reward"`
+	ReceiverSigRequired           bool      `json:"receiver_sig_required"`
+	StakedAccountID               *string   `json:"staked_account_id"`
+	StakedNodeID                  *int64    `json:"staked_node_id"`
+	StakePeriodStart              Timestamp `json:"stake_period_start"`
+}
+
+// AccountBalance is the balance of an account returned by /api/v1/balances.
+type AccountBalance struct {
+	Account string         `json:"account"`
+	Balance int64          `json:"balance"`
+	Tokens  []TokenBalance `json:"tokens"`
+}
+
+// Transfer is an hbar transfer of a transaction, in tinybars.
+type Transfer struct {
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// TokenTransfer is a fungible token transfer of a transaction.
+type TokenTransfer struct {
+	TokenID    string `json:"token_id"`
+	Account    string `json:"account"`
+	Amount     int64  `json:"amount"`
+	IsApproval bool   `json:"is_approval"`
+}
+
+// NftTransfer is an NFT transfer of a transaction.
+type NftTransfer struct {
+	TokenID           string  `json:"token_id"`
+	SerialNumber      int64   `json:"serial_number"`
+	SenderAccountID   *string `json:"sender_account_id"`
+	ReceiverAccountID *string `json:"receiver_account_id"`
+	IsApproval        bool    `json:"is_approval"`
+}
+
+// StakingRewardTransfer is a staking reward paid to an account by a transaction, in tinybars.
+type StakingRewardTransfer struct {
+	Account string `json:"account"`
+	Amount  int64  `json:"amount"`
+}
+
+// Transaction is a transaction returned by /api/v1/transactions.
+type Transaction struct {
+	Bytes                    []byte                  `json:"bytes"`
+	ChargedTxFee             int64                   `json:"charged_tx_fee"`
+	ConsensusTimestamp       Timestamp               `json:"consensus_timestamp"`
+	EntityID                 *string                 `json:"entity_id"`
+	MaxFee                   string                  `json:"max_fee"`
+	Memo                     []byte                  `json:"memo_base64"`
+	Name                     string                  `json:"name"`
+	NftTransfers             []NftTransfer           `json:"nft_transfers"`
+	Node                     *string                 `json:"node"`
+	Nonce                    int32                   `json:"nonce"`
+	ParentConsensusTimestamp *Timestamp              `json:"parent_consensus_timestamp"`
+	Result                   string                  `json:"result"`
+	Scheduled                bool                    `json:"scheduled"`
+	StakingRewardTransfers   []StakingRewardTransfer `json:"staking_reward_transfers"`
+	TokenTransfers           []TokenTransfer         `json:"token_transfers"`
+	TransactionHash          []byte                  `json:"transaction_hash"`
+	TransactionID            string                  `json:"transaction_id"`
+	Transfers                []Transfer              `json:"transfers"`
+	ValidDurationSeconds     string                  `json:"valid_duration_seconds"`
+	ValidStartTimestamp      Timestamp               `json:"valid_start_timestamp"`
+}
+
+// Token is a token returned by /api/v1/tokens.
+type Token struct {
+	TokenID  string `json:"token_id"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Decimals int64  `json:"decimals"`
+	AdminKey *Key   `json:"admin_key"`
+	Metadata []byte `json:"metadata"`
+}
+
+// TokenInfo is the detail of a token returned by /api/v1/tokens/{tokenId}. The supplies and decimals are decimal
+// strings, as returned by the mirror node.
+type TokenInfo struct {
+	TokenID           string    `json:"token_id"`
+	Symbol            string    `json:"symbol"`
+	Name              string    `json:"name"`
+	Type              string    `json:"type"`
+	Decimals          string    `json:"decimals"`
+	InitialSupply     string    `json:"initial_supply"`
+	TotalSupply       string    `json:"total_supply"`
+	MaxSupply         string    `json:"max_supply"`
+	SupplyType        string    `json:"supply_type"`
+	TreasuryAccountID string    `json:"treasury_account_id"`
+	AutoRenewAccount  *string   `json:"auto_renew_account"`
+	AutoRenewPeriod   *int64    `json:"auto_renew_period"`
+	CreatedTimestamp  Timestamp `json:"created_timestamp"`
+	ModifiedTimestamp Timestamp `json:"modified_timestamp"`
+	ExpiryTimestamp   *int64    `json:"expiry_timestamp"`
+	Deleted           *bool     `json:"deleted"`
+	FreezeDefault     bool      `json:"freeze_default"`
+	PauseStatus       string    `json:"pause_status"`
+	Memo              string    `json:"memo"`
+	Metadata          []byte    `json:"metadata"`
+	AdminKey          *Key      `json:"admin_key"`
+	FreezeKey         *Key      `json:"freeze_key"`
+	KycKey            *Key      `json:"kyc_key"`
+	SupplyKey         *Key      `json:"supply_key"`
+	WipeKey           *Key      `json:"wipe_key"`
+	PauseKey          *Key      `json:"pause_key"`
+	FeeScheduleKey    *Key      `json:"fee_schedule_key"`
+	MetadataKey       *Key      `json:"metadata_key"`
+}
+
+// Nft is a non-fungible token returned by /api/v1/tokens/{tokenId}/nfts or /api/v1/accounts/{idOrAliasOrEvmAddress}/nfts.
+type Nft struct {
+	AccountID         *string   `json:"account_id"`
+	CreatedTimestamp  Timestamp `json:"created_timestamp"`
+	DelegatingSpender *string   `json:"delegating_spender"`
+	Deleted           bool      `json:"deleted"`
+	Metadata          []byte    `json:"metadata"`
+	ModifiedTimestamp Timestamp `json:"modified_timestamp"`
+	SerialNumber      int64     `json:"serial_number"`
+	Spender           *string   `json:"spender"`
+	TokenID           string    `json:"token_id"`
+}
+
+// ChunkTransactionID is the transaction ID of the first chunk of a chunked topic message.
+type ChunkTransactionID struct {
+	AccountID             string    `json:"account_id"`
+	Nonce                 int32     `json:"nonce"`
+	Scheduled             bool      `json:"scheduled"`
+	TransactionValidStart Timestamp `json:"transaction_valid_start"`
+}
+
+// ChunkInfo is the position of a chunk in a chunked topic message.
+type ChunkInfo struct {
+	InitialTransactionID ChunkTransactionID `json:"initial_transaction_id"`
+	Number               int32              `json:"number"`
+	Total                int32              `json:"total"`
+}
+
+// TopicMessage is a message returned by /api/v1/topics/{topicId}/messages. Chunks of chunked messages are returned
+// one by one.
+type TopicMessage struct {
+	ChunkInfo          *ChunkInfo `json:"chunk_info"`
+	ConsensusTimestamp Timestamp  `json:"consensus_timestamp"`
+	Message            []byte     `json:"message"`
+	PayerAccountID     string     `json:"payer_account_id"`
+	RunningHash        []byte     `json:"running_hash"`
+	RunningHashVersion int32      `json:"running_hash_version"`
+	SequenceNumber     uint64     `json:"sequence_number"`
+	TopicID            string     `json:"topic_id"`
+}
+
+// ContractResult is the result of a contract call or creation returned by /api/v1/contracts/{contractIdOrAddress}/results
+// or /api/v1/contracts/results/{transactionIdOrHash}. The byte fields are hex strings starting with 0x, as returned by the
+// mirror node.
+type ContractResult struct {
+	Address            string    `json:"address"`
+	Amount             int64     `json:"amount"`
+	BlockHash          string    `json:"block_hash"`
+	BlockNumber        int64     `json:"block_number"`
+	Bloom              string    `json:"bloom"`
+	CallResult         string    `json:"call_result"`
+	ContractID         *string   `json:"contract_id"`
+	CreatedContractIDs []string  `json:"created_contract_ids"`
+	ErrorMessage       *string   `json:"error_message"`
+	From               string    `json:"from"`
+	FunctionParameters string    `json:"function_parameters"`
+	GasConsumed        *int64    `json:"gas_consumed"`
+	GasLimit           int64     `json:"gas_limit"`
+	GasUsed            *int64    `json:"gas_used"`
+	Hash               string    `json:"hash"`
+	Result             string    `json:"result"`
+	Status             string    `json:"status"`
+	Timestamp          Timestamp `json:"timestamp"`
+	To                 *string   `json:"to"`
+}
+
+// ContractLog is a log emitted by a contract returned by /api/v1/contracts/{contractIdOrAddress}/results/logs. The data
+// and topics are hex strings starting with 0x, as returned by the mirror node.
+type ContractLog struct {
+	Address          string    `json:"address"`
+	BlockHash        string    `json:"block_hash"`
+	BlockNumber      int64     `json:"block_number"`
+	Bloom            string    `json:"bloom"`
+	ContractID       string    `json:"contract_id"`
+	Data             string    `json:"data"`
+	Index            int64     `json:"index"`
+	RootContractID   *string   `json:"root_contract_id"`
+	Timestamp        Timestamp `json:"timestamp"`
+	Topics           []string  `json:"topics"`
+	TransactionHash  string    `json:"transaction_hash"`
+	TransactionIndex *int64    `json:"transaction_index"`
+}
+
+// ScheduleSignature is a signature added to a scheduled transaction.
+type ScheduleSignature struct {
+	ConsensusTimestamp Timestamp `json:"consensus_timestamp"`
+	PublicKeyPrefix    []byte    `json:"public_key_prefix"`
+	Signature          []byte    `json:"signature"`
+	Type               string    `json:"type"`
+}
+
+// Schedule is a scheduled transaction returned by /api/v1/schedules.
+type Schedule struct {
+	AdminKey           *Key                `json:"admin_key"`
+	ConsensusTimestamp Timestamp           `json:"consensus_timestamp"`
+	CreatorAccountID   string              `json:"creator_account_id"`
+	Deleted            bool                `json:"deleted"`
+	ExecutedTimestamp  *Timestamp          `json:"executed_timestamp"`
+	ExpirationTime     *Timestamp          `json:"expiration_time"`
+	Memo               string              `json:"memo"`
+	PayerAccountID     string              `json:"payer_account_id"`
+	ScheduleID         string              `json:"schedule_id"`
+	Signatures         []ScheduleSignature `json:"signatures"`
+	TransactionBody    []byte              `json:"transaction_body"`
+	WaitForExpiry      bool                `json:"wait_for_expiry"`
+}
+
+// NetworkSupply is the supply of hbars, in tinybars as decimal strings.
+type NetworkSupply struct {
+	ReleasedSupply string    `json:"released_supply"`
+	Timestamp      Timestamp `json:"timestamp"`
+	TotalSupply    string    `json:"total_supply"`
+}
+
+// NetworkFee is the gas price of a type of transaction, in tinybars.
+type NetworkFee struct {
+	Gas             int64  `json:"gas"`
+	TransactionType string `json:"transaction_type"`
+}
+
+// NetworkFees are the gas prices of the transaction types.
+type NetworkFees struct {
+	Fees      []NetworkFee `json:"fees"`
+	Timestamp Timestamp    `json:"timestamp"`
+}
+
+// Rate is an exchange rate between hbars and cents of USD.
+type Rate struct {
+	CentEquivalent int32 `json:"cent_equivalent"`
+	ExpirationTime int64 `json:"expiration_time"`
+	HbarEquivalent int32 `json:"hbar_equivalent"`
+}
+
+// ExchangeRate is the current and next exchange rate between hbars and cents of USD.
+type ExchangeRate struct {
+	CurrentRate Rate      `json:"current_rate"`
+	NextRate    Rate      `json:"next_rate"`
+	Timestamp   Timestamp `json:"timestamp"`
+}
@@ -6,8 +6,6 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"math/big"
-	"regexp"
-	"strconv"
 	"strings"
 
 	"crypto/sha512"
@@ -19,7 +17,8 @@ import (
 )
 
 type Mnemonic struct {
-	words string
+	words    string
+	language MnemonicLanguage
 }
 
 // Deprecated
@@ -29,27 +28,26 @@ func (m Mnemonic) ToPrivateKey(passPhrase string) (PrivateKey, error) {
 
 // GenerateMnemonic generates a random 24-word mnemonic
 func GenerateMnemonic24() (Mnemonic, error) {
-	entropy, err := bip39.NewEntropy(256)
-
-	if err != nil {
-		// It is only possible for there to be an error if the operating
-		// system's rng is unreadable
-		return Mnemonic{}, fmt.Errorf("could not retrieve random bytes from the operating system")
-	}
+	return GenerateMnemonic24WithLanguage(MnemonicLanguageEnglish)
+}
 
-	mnemonic, err := bip39.NewMnemonic(entropy)
+// GenerateMnemonic12 generates a random 12-word mnemonic
+func GenerateMnemonic12() (Mnemonic, error) {
+	return GenerateMnemonic12WithLanguage(MnemonicLanguageEnglish)
+}
 
-	// Note that this should never actually fail since it is being provided by library generated mnemonic
-	if err != nil {
-		return Mnemonic{}, err
-	}
+// GenerateMnemonic24WithLanguage generates a random 24-word mnemonic from the wordlist of the given language
+func GenerateMnemonic24WithLanguage(language MnemonicLanguage) (Mnemonic, error) {
+	return _GenerateMnemonic(256, language)
+}
 
-	return Mnemonic{mnemonic}, nil
+// GenerateMnemonic12WithLanguage generates a random 12-word mnemonic from the wordlist of the given language
+func GenerateMnemonic12WithLanguage(language MnemonicLanguage) (Mnemonic, error) {
+	return _GenerateMnemonic(128, language)
 }
 
-// GenerateMnemonic12 generates a random 12-word mnemonic
-func GenerateMnemonic12() (Mnemonic, error) {
-	entropy, err := bip39.NewEntropy(128)
+func _GenerateMnemonic(bitSize int, language MnemonicLanguage) (Mnemonic, error) {
+	entropy, err := bip39.NewEntropy(bitSize)
 
 	if err != nil {
 		// It is only possible for there to be an error if the operating
@@ -57,21 +55,20 @@ func GenerateMnemonic12() (Mnemonic, error) {
 		return Mnemonic{}, fmt.Errorf("could not retrieve random bytes from the operating system")
 	}
 
-	mnemonic, err := bip39.NewMnemonic(entropy)
-
-	// Note that this should never actually fail since it is being provided by library generated mnemonic
-	if err != nil {
-		return Mnemonic{}, err
-	}
-
-	return Mnemonic{mnemonic}, nil
+	return _MnemonicFromEntropy(entropy, language)
 }
 
 // MnemonicFromString creates a mnemonic from a string of 24 words separated by spaces
 //
-// Keys are lazily generated
+// The language of the words is detected automatically. Keys are lazily generated
 func MnemonicFromString(s string) (Mnemonic, error) {
-	return NewMnemonic(strings.Split(s, " "))
+	return NewMnemonic(strings.Fields(s))
+}
+
+// MnemonicFromStringWithLanguage creates a mnemonic from a string of 12 or 24 words of the given language separated by
+// spaces
+func MnemonicFromStringWithLanguage(s string, language MnemonicLanguage) (Mnemonic, error) {
+	return NewMnemonicWithLanguage(strings.Fields(s), language)
 }
 
 // String returns the mnemonic as a string.
@@ -81,34 +78,70 @@ func (m Mnemonic) String() string {
 
 // Words returns the mnemonic as a slice of strings
 func (m Mnemonic) Words() []string {
-	return strings.Split(m.words, " ")
+	return strings.Fields(m.words)
+}
+
+// Language returns the language of the wordlist of the mnemonic. Legacy 22-word mnemonics are reported as English.
+func (m Mnemonic) Language() MnemonicLanguage {
+	return m.language
 }
 
 // NewMnemonic Creates a mnemonic from a slice of 24 strings
 //
-// Keys are lazily generated
+// The language of the words is detected automatically; words that are in the wordlists of several languages are
+// resolved in favour of a language in which the checksum is valid. An ErrMnemonicUnknownWord or ErrMnemonicChecksum
+// is returned for invalid mnemonics. Keys are lazily generated
 func NewMnemonic(words []string) (Mnemonic, error) {
-	joinedString := strings.Join(words, " ")
-
-	if len(words) == 24 || len(words) == 12 || len(words) == 22 {
-		if len(words) == 22 { //nolint
-			return Mnemonic{
-				words: joinedString,
-			}._LegacyValidate()
-		} else if bip39.IsMnemonicValid(joinedString) {
-			return Mnemonic{
-				words: joinedString,
-			}, nil
-		} else {
-			return Mnemonic{}, fmt.Errorf("invalid mnemonic composition")
-		}
-	} else {
+	if len(words) == 22 { //nolint
+		return Mnemonic{
+			words: strings.Join(words, " "),
+		}._LegacyValidate()
+	}
+
+	language, ok := _DetectMnemonicLanguage(words)
+	if !ok && len(words) != 12 && len(words) != 24 {
+		return Mnemonic{}, fmt.Errorf("invalid mnemonic string")
+	}
+
+	return NewMnemonicWithLanguage(words, language)
+}
+
+// NewMnemonicWithLanguage creates a mnemonic from a slice of 12 or 24 words of the given language. Words may be in
+// any unicode normalization form. An ErrMnemonicUnknownWord or ErrMnemonicChecksum is returned for invalid mnemonics.
+func NewMnemonicWithLanguage(words []string, language MnemonicLanguage) (Mnemonic, error) {
+	if len(words) != 12 && len(words) != 24 {
 		return Mnemonic{}, fmt.Errorf("invalid mnemonic string")
 	}
+
+	wordlist, err := language._Wordlist()
+	if err != nil {
+		return Mnemonic{}, err
+	}
+
+	indices, err := wordlist._WordIndices(words)
+	if err != nil {
+		return Mnemonic{}, err
+	}
+
+	if !_MnemonicChecksumValid(indices) {
+		return Mnemonic{}, ErrMnemonicChecksum{
+			Suggestions: wordlist._ChecksumSuggestions(indices),
+		}
+	}
+
+	canonical := make([]string, len(indices))
+	for i, index := range indices {
+		canonical[i] = wordlist.words[index]
+	}
+
+	return Mnemonic{
+		words:    strings.Join(canonical, language._Separator()),
+		language: language,
+	}, nil
 }
 
 func (m Mnemonic) _LegacyValidate() (Mnemonic, error) {
-	if len(strings.Split(m.words, " ")) != 22 {
+	if len(m.Words()) != 22 {
 		return Mnemonic{}, fmt.Errorf("not a legacy mnemonic")
 	}
 
@@ -130,9 +163,9 @@ func (m Mnemonic) _LegacyValidate() (Mnemonic, error) {
 func (m Mnemonic) _Indices() ([]int, error) {
 	var indices []int
 	var check bool
-	temp := strings.Split(m.words, " ")
-	if len(temp) == 22 { // nolint
-		for _, mnemonicString := range strings.Split(m.words, " ") {
+	words := m.Words()
+	if len(words) == 22 { // nolint
+		for _, mnemonicString := range words {
 			check = false
 			for i, stringCheck := range legacy {
 				if mnemonicString == stringCheck {
@@ -144,13 +177,16 @@ func (m Mnemonic) _Indices() ([]int, error) {
 				return make([]int, 0), fmt.Errorf("word is not in the legacy word list")
 			}
 		}
-	} else if len(temp) == 24 {
-		for _, mnemonicString := range strings.Split(m.words, " ") {
-			t, check := bip39.GetWordIndex(mnemonicString)
-			if !check {
-				return make([]int, 0), bip39.ErrInvalidMnemonic
-			}
-			indices = append(indices, t)
+	} else if len(words) == 24 {
+		// The words are in the language of the mnemonic, separated by an ideographic space for Japanese
+		wordlist, err := m.language._Wordlist()
+		if err != nil {
+			return make([]int, 0), err
+		}
+
+		indices, err = wordlist._WordIndices(words)
+		if err != nil {
+			return make([]int, 0), err
 		}
 	} else {
 		return make([]int, 0), errors.New("not a 22 word or a 24 mnemonic")
@@ -170,7 +206,7 @@ func (m Mnemonic) ToLegacyPrivateKey() (PrivateKey, error) {
 	if len(indices) == 22 { // nolint
 		entropy, _ = m._ToLegacyEntropy(indices)
 	} else if len(indices) == 24 {
-		entropy, err = m._ToLegacyEntropy2()
+		entropy, err = m._ToLegacyEntropy2(indices)
 		if err != nil {
 			return PrivateKey{}, err
 		}
@@ -210,8 +246,7 @@ func (m Mnemonic) _ToLegacyEntropy(indices []int) ([]byte, uint8) {
 	return result, checksum
 }
 
-func (m Mnemonic) _ToLegacyEntropy2() ([]byte, error) {
-	indices := strings.Split(m.words, " ")
+func (m Mnemonic) _ToLegacyEntropy2(indices []int) ([]byte, error) {
 	concatBitsLen := len(indices) * 11
 	concatBits := make([]bool, concatBitsLen)
 
@@ -219,12 +254,7 @@ func (m Mnemonic) _ToLegacyEntropy2() ([]byte, error) {
 		concatBits[i] = false
 	}
 
-	for index, word := range indices {
-		nds, check := bip39.GetWordIndex(word)
-		if !check {
-			return make([]byte, 0), bip39.ErrInvalidMnemonic
-		}
-
+	for index, nds := range indices {
 		for i := 0; i < 11; i++ {
 			concatBits[(index*11)+i] = (nds & (1 << (10 - i))) != 0
 		}
@@ -259,10 +289,17 @@ func (m Mnemonic) _ToLegacyEntropy2() ([]byte, error) {
 	return entropy, nil
 }
 
+// ToSeed returns the BIP-39 seed of the mnemonic. Both the mnemonic and the passphrase are NFKD normalized first, so
+// that the seed does not depend on how the words or the passphrase were typed.
+func (m Mnemonic) ToSeed(passPhrase string) []byte {
+	return m._ToSeed(passPhrase)
+}
+
 func (m Mnemonic) _ToSeed(passPhrase string) []byte {
+	mnemonicNFKD := norm.NFKD.String(m.String())
 	passPhraseNFKD := norm.NFKD.String(passPhrase)
 	salt := []byte("mnemonic" + passPhraseNFKD)
-	seed := pbkdf2.Key([]byte(m.String()), salt, 2048, 64, sha512.New)
+	seed := pbkdf2.Key([]byte(mnemonicNFKD), salt, 2048, 64, sha512.New)
 	return seed
 }
 
@@ -296,31 +333,7 @@ func (m Mnemonic) ToStandardEd25519PrivateKey(passPhrase string, index uint32) (
 
 // calculateDerivationPathValues converts a derivation path string to an array of integers
 func calculateDerivationPathValues(derivationPath string) ([]uint32, error) {
-	re := regexp.MustCompile(`m/(\d+'?)/(\d+'?)/(\d+'?)/(\d+'?)/(\d+'?)`)
-	matches := re.FindStringSubmatch(derivationPath)
-	if len(matches) != 6 {
-		return nil, fmt.Errorf("invalid derivation path format")
-	}
-
-	values := make([]uint32, 5)
-	for i, match := range matches[1:] {
-		if strings.HasSuffix(match, "'") {
-			match = strings.TrimSuffix(match, "'")
-			value, err := strconv.Atoi(match)
-			if err != nil {
-				return nil, err
-			}
-			values[i] = ToHardenedIndex(uint32(value))
-		} else {
-			value, err := strconv.Atoi(match)
-			if err != nil {
-				return nil, err
-			}
-			values[i] = uint32(value)
-		}
-	}
-
-	return values, nil
+	return _ParseDerivationPath(derivationPath)
 }
 
 func (m Mnemonic) toStandardECDSAsecp256k1PrivateKeyImpl(passPhrase string, derivationPathValues []uint32) (PrivateKey, error) {
@@ -330,7 +343,7 @@ func (m Mnemonic) toStandardECDSAsecp256k1PrivateKeyImpl(passPhrase string, deri
 		return PrivateKey{}, err
 	}
 
-	keyBytes, chainCode := derivedKey.keyData.ToECDSA().D.Bytes(), derivedKey.chainCode
+	keyBytes, chainCode := derivedKey._BytesRaw(), derivedKey.chainCode
 	for _, i := range derivationPathValues {
 		keyBytes, chainCode, err = _DeriveECDSAChildKey(keyBytes, chainCode, i)
 		if err != nil {
@@ -369,7 +382,7 @@ func (m Mnemonic) ToStandardECDSAsecp256k1PrivateKey(passPhrase string, index ui
 		return PrivateKey{}, err
 	}
 
-	keyBytes, chainCode := derivedKey.keyData.ToECDSA().D.Bytes(), derivedKey.chainCode
+	keyBytes, chainCode := derivedKey._BytesRaw(), derivedKey.chainCode
 	for _, i := range []uint32{
 		ToHardenedIndex(44),
 		ToHardenedIndex(3030),
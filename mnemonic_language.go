@@ -0,0 +1,379 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MnemonicLanguage is the language of the BIP-39 wordlist of a mnemonic
+type MnemonicLanguage int
+
+const (
+	MnemonicLanguageEnglish MnemonicLanguage = iota
+	MnemonicLanguageJapanese
+	MnemonicLanguageSpanish
+	MnemonicLanguageChineseSimplified
+	MnemonicLanguageChineseTraditional
+	MnemonicLanguageFrench
+	MnemonicLanguageItalian
+	MnemonicLanguageKorean
+	MnemonicLanguageCzech
+)
+
+// mnemonicLanguages are the supported languages, in the order they are tried when detecting the language of a mnemonic
+var mnemonicLanguages = []MnemonicLanguage{
+	MnemonicLanguageEnglish,
+	MnemonicLanguageJapanese,
+	MnemonicLanguageSpanish,
+	MnemonicLanguageChineseSimplified,
+	MnemonicLanguageChineseTraditional,
+	MnemonicLanguageFrench,
+	MnemonicLanguageItalian,
+	MnemonicLanguageKorean,
+	MnemonicLanguageCzech,
+}
+
+// maxMnemonicSuggestionDistance is the largest edit distance between a word and the words suggested in its place
+const maxMnemonicSuggestionDistance = 2
+
+// maxMnemonicSuggestions is the largest number of words suggested for an unknown word
+const maxMnemonicSuggestions = 5
+
+// _MnemonicWordlist is a BIP-39 wordlist together with an index of its NFKD normalized words
+type _MnemonicWordlist struct {
+	words      []string
+	normalized []string
+	indices    map[string]int
+}
+
+var mnemonicWordlists sync.Map
+
+// String returns the name of the language
+func (language MnemonicLanguage) String() string {
+	switch language {
+	case MnemonicLanguageEnglish:
+		return "English"
+	case MnemonicLanguageJapanese:
+		return "Japanese"
+	case MnemonicLanguageSpanish:
+		return "Spanish"
+	case MnemonicLanguageChineseSimplified:
+		return "ChineseSimplified"
+	case MnemonicLanguageChineseTraditional:
+		return "ChineseTraditional"
+	case MnemonicLanguageFrench:
+		return "French"
+	case MnemonicLanguageItalian:
+		return "Italian"
+	case MnemonicLanguageKorean:
+		return "Korean"
+	case MnemonicLanguageCzech:
+		return "Czech"
+	default:
+		return fmt.Sprintf("MnemonicLanguage(%d)", int(language))
+	}
+}
+
+// _Separator returns the separator of the words of a mnemonic; Japanese mnemonics use an ideographic space
+func (language MnemonicLanguage) _Separator() string {
+	if language == MnemonicLanguageJapanese {
+		return "\u3000"
+	}
+
+	return " "
+}
+
+func (language MnemonicLanguage) _Wordlist() (*_MnemonicWordlist, error) {
+	if wordlist, ok := mnemonicWordlists.Load(language); ok {
+		return wordlist.(*_MnemonicWordlist), nil
+	}
+
+	var words []string
+	switch language {
+	case MnemonicLanguageEnglish:
+		words = wordlists.English
+	case MnemonicLanguageJapanese:
+		words = wordlists.Japanese
+	case MnemonicLanguageSpanish:
+		words = wordlists.Spanish
+	case MnemonicLanguageChineseSimplified:
+		words = wordlists.ChineseSimplified
+	case MnemonicLanguageChineseTraditional:
+		words = wordlists.ChineseTraditional
+	case MnemonicLanguageFrench:
+		words = wordlists.French
+	case MnemonicLanguageItalian:
+		words = wordlists.Italian
+	case MnemonicLanguageKorean:
+		words = wordlists.Korean
+	case MnemonicLanguageCzech:
+		words = wordlists.Czech
+	default:
+		return nil, fmt.Errorf("unsupported mnemonic language: %v", language)
+	}
+
+	wordlist := &_MnemonicWordlist{
+		words:      words,
+		normalized: make([]string, len(words)),
+		indices:    make(map[string]int, len(words)),
+	}
+	for i, word := range words {
+		wordlist.normalized[i] = norm.NFKD.String(word)
+		wordlist.indices[wordlist.normalized[i]] = i
+	}
+
+	actual, _ := mnemonicWordlists.LoadOrStore(language, wordlist)
+	return actual.(*_MnemonicWordlist), nil
+}
+
+// _Index returns the index of a word, which may be in any unicode normalization form
+func (wordlist *_MnemonicWordlist) _Index(word string) (int, bool) {
+	index, ok := wordlist.indices[norm.NFKD.String(word)]
+	return index, ok
+}
+
+// _Suggestions returns the words of the list closest to word, nearest first
+func (wordlist *_MnemonicWordlist) _Suggestions(word string) []string {
+	type candidate struct {
+		index    int
+		distance int
+	}
+
+	normalized := norm.NFKD.String(word)
+	candidates := make([]candidate, 0)
+	for i, listWord := range wordlist.normalized {
+		if distance := _LevenshteinDistance(normalized, listWord); distance <= maxMnemonicSuggestionDistance {
+			candidates = append(candidates, candidate{i, distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	suggestions := make([]string, 0, maxMnemonicSuggestions)
+	for _, candidate := range candidates {
+		if len(suggestions) == maxMnemonicSuggestions {
+			break
+		}
+		suggestions = append(suggestions, wordlist.words[candidate.index])
+	}
+
+	return suggestions
+}
+
+// _DetectMnemonicLanguage returns the first language whose wordlist holds every word. Wordlists share some words, so
+// a language in which the checksum is valid is preferred.
+func _DetectMnemonicLanguage(words []string) (MnemonicLanguage, bool) {
+	detected := make([]MnemonicLanguage, 0)
+	for _, language := range mnemonicLanguages {
+		wordlist, err := language._Wordlist()
+		if err != nil {
+			continue
+		}
+
+		if wordlist._ContainsAll(words) {
+			detected = append(detected, language)
+		}
+	}
+
+	if len(detected) == 0 {
+		return MnemonicLanguageEnglish, false
+	}
+
+	for _, language := range detected {
+		wordlist, _ := language._Wordlist()
+		indices, _ := wordlist._WordIndices(words)
+		if _MnemonicChecksumValid(indices) {
+			return language, true
+		}
+	}
+
+	return detected[0], true
+}
+
+func (wordlist *_MnemonicWordlist) _ContainsAll(words []string) bool {
+	for _, word := range words {
+		if _, ok := wordlist._Index(word); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// _WordIndices returns the index of each word, or an ErrMnemonicUnknownWord for the first word not in the list
+func (wordlist *_MnemonicWordlist) _WordIndices(words []string) ([]int, error) {
+	indices := make([]int, len(words))
+	for i, word := range words {
+		index, ok := wordlist._Index(word)
+		if !ok {
+			return nil, ErrMnemonicUnknownWord{
+				Index:       i,
+				Word:        word,
+				Suggestions: wordlist._Suggestions(word),
+			}
+		}
+		indices[i] = index
+	}
+
+	return indices, nil
+}
+
+// _ChecksumSuggestions returns the single word replacements which make the checksum of a mnemonic valid, among the
+// words close to the ones of the mnemonic
+func (wordlist *_MnemonicWordlist) _ChecksumSuggestions(indices []int) []MnemonicWordSuggestion {
+	suggestions := make([]MnemonicWordSuggestion, 0)
+	candidate := append([]int{}, indices...)
+	for position, index := range indices {
+		for replacement, listWord := range wordlist.normalized {
+			if replacement == index {
+				continue
+			}
+
+			distance := _LevenshteinDistance(wordlist.normalized[index], listWord)
+			if distance > maxMnemonicSuggestionDistance {
+				continue
+			}
+
+			candidate[position] = replacement
+			if _MnemonicChecksumValid(candidate) {
+				suggestions = append(suggestions, MnemonicWordSuggestion{
+					Index:       position,
+					Word:        wordlist.words[index],
+					Replacement: wordlist.words[replacement],
+					Distance:    distance,
+				})
+			}
+		}
+		candidate[position] = index
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Distance < suggestions[j].Distance
+	})
+
+	return suggestions
+}
+
+// _MnemonicChecksumValid checks the BIP-39 checksum held in the last bits of the word indices
+func _MnemonicChecksumValid(indices []int) bool {
+	bits := len(indices) * 11
+	checksumBits := bits / 33
+	if len(indices) == 0 || len(indices)%3 != 0 {
+		return false
+	}
+
+	data := make([]byte, (bits+7)/8)
+	for i, index := range indices {
+		for bit := 0; bit < 11; bit++ {
+			if index&(1<<(10-bit)) != 0 {
+				position := i*11 + bit
+				data[position/8] |= 1 << (7 - position%8)
+			}
+		}
+	}
+
+	entropy := data[:(bits-checksumBits)/8]
+	hash := sha256.Sum256(entropy)
+	for bit := 0; bit < checksumBits; bit++ {
+		position := len(entropy)*8 + bit
+		if (data[position/8]>>(7-position%8))&1 != (hash[bit/8]>>(7-bit%8))&1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// _MnemonicFromEntropy encodes entropy as the words of a mnemonic in the given language
+func _MnemonicFromEntropy(entropy []byte, language MnemonicLanguage) (Mnemonic, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return Mnemonic{}, fmt.Errorf("BIP-39 entropy must be 16, 20, 24, 28 or 32 bytes, got %v", len(entropy))
+	}
+
+	wordlist, err := language._Wordlist()
+	if err != nil {
+		return Mnemonic{}, err
+	}
+
+	hash := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), hash[0])
+	count := len(entropy) * 8 / 32 * 3
+
+	words := make([]string, count)
+	for i := range words {
+		index := 0
+		for bit := 0; bit < 11; bit++ {
+			position := i*11 + bit
+			index = index<<1 | int((data[position/8]>>(7-position%8))&1)
+		}
+		words[i] = wordlist.words[index]
+	}
+
+	return Mnemonic{
+		words:    strings.Join(words, language._Separator()),
+		language: language,
+	}, nil
+}
+
+// _Entropy returns the entropy encoded by a 12 or 24-word mnemonic
+func (m Mnemonic) _Entropy() ([]byte, error) {
+	words := m.Words()
+	if len(words) != 12 && len(words) != 24 {
+		return nil, fmt.Errorf("only 12 and 24-word mnemonics hold BIP-39 entropy")
+	}
+
+	wordlist, err := m.language._Wordlist()
+	if err != nil {
+		return nil, err
+	}
+
+	indices, err := wordlist._WordIndices(words)
+	if err != nil {
+		return nil, err
+	}
+
+	entropy := make([]byte, len(words)*11*32/33/8)
+	for i, index := range indices {
+		for bit := 0; bit < 11; bit++ {
+			position := i*11 + bit
+			if position/8 < len(entropy) && index&(1<<(10-bit)) != 0 {
+				entropy[position/8] |= 1 << (7 - position%8)
+			}
+		}
+	}
+
+	return entropy, nil
+}
+
+func _LevenshteinDistance(a string, b string) int {
+	first, second := []rune(a), []rune(b)
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current[0] = i
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+			current[j] = min(min(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(second)]
+}
@@ -0,0 +1,172 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestUnitMnemonicJapaneseVector(t *testing.T) {
+	t.Parallel()
+
+	// The first of the Japanese BIP-39 test vectors, which checks NFKD normalization of both inputs
+	mnemonic, err := _MnemonicFromEntropy(make([]byte, 16), MnemonicLanguageJapanese)
+	require.NoError(t, err)
+	assert.Equal(t, norm.NFKD.String(strings.Repeat("あいこくしん　", 11)+"あおぞら"), norm.NFKD.String(mnemonic.String()))
+
+	parsed, err := MnemonicFromString(mnemonic.String())
+	require.NoError(t, err)
+	assert.Equal(t, MnemonicLanguageJapanese, parsed.Language())
+	assert.Len(t, parsed.Words(), 12)
+
+	seed := parsed.ToSeed("㍍ガバヴァぱばぐゞちぢ十人十色")
+	assert.Equal(t, "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55", hex.EncodeToString(seed))
+
+	// Words separated by plain spaces give the same seed
+	spaced, err := MnemonicFromStringWithLanguage(strings.Join(parsed.Words(), " "), MnemonicLanguageJapanese)
+	require.NoError(t, err)
+	assert.Equal(t, seed, spaced.ToSeed("㍍ガバヴァぱばぐゞちぢ十人十色"))
+}
+
+func TestUnitMnemonicGenerateWithLanguage(t *testing.T) {
+	t.Parallel()
+
+	for _, language := range mnemonicLanguages {
+		mnemonic, err := GenerateMnemonic24WithLanguage(language)
+		require.NoError(t, err)
+		assert.Len(t, mnemonic.Words(), 24)
+		assert.Equal(t, language, mnemonic.Language())
+
+		parsed, err := NewMnemonicWithLanguage(mnemonic.Words(), language)
+		require.NoError(t, err, language.String())
+		assert.Equal(t, mnemonic.String(), parsed.String())
+
+		mnemonic, err = GenerateMnemonic12WithLanguage(language)
+		require.NoError(t, err)
+		assert.Len(t, mnemonic.Words(), 12)
+
+		_, err = mnemonic.ToStandardEd25519PrivateKey("", 0)
+		require.NoError(t, err)
+	}
+}
+
+func TestUnitMnemonicDetectLanguage(t *testing.T) {
+	t.Parallel()
+
+	for _, language := range []MnemonicLanguage{MnemonicLanguageSpanish, MnemonicLanguageFrench, MnemonicLanguageChineseSimplified} {
+		mnemonic, err := GenerateMnemonic24WithLanguage(language)
+		require.NoError(t, err)
+
+		parsed, err := MnemonicFromString(mnemonic.String())
+		require.NoError(t, err)
+		assert.Equal(t, mnemonic.ToSeed(""), parsed.ToSeed(""))
+
+		// Chinese wordlists share characters, but the checksum only matches in one of them most of the time
+		if language != MnemonicLanguageChineseSimplified {
+			assert.Equal(t, language, parsed.Language())
+		}
+	}
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+	assert.Equal(t, MnemonicLanguageEnglish, mnemonic.Language())
+}
+
+func TestUnitMnemonicNormalizationForms(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := _MnemonicFromEntropy(make([]byte, 32), MnemonicLanguageSpanish)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(norm.NFC.String(mnemonic.String()), "ábaco"))
+
+	for _, form := range []norm.Form{norm.NFC, norm.NFD, norm.NFKC, norm.NFKD} {
+		parsed, err := MnemonicFromString(form.String(mnemonic.String()))
+		require.NoError(t, err)
+		assert.Equal(t, MnemonicLanguageSpanish, parsed.Language())
+		assert.Equal(t, mnemonic.ToSeed("contraseña"), parsed.ToSeed(norm.NFD.String("contraseña")))
+	}
+}
+
+func TestUnitMnemonicUnknownWord(t *testing.T) {
+	t.Parallel()
+
+	words := strings.Fields(strings.Repeat("abandon ", 11) + "about")
+	words[4] = "abandun"
+
+	_, err := NewMnemonic(words)
+	require.Error(t, err)
+
+	var unknownWord ErrMnemonicUnknownWord
+	require.True(t, errors.As(err, &unknownWord))
+	assert.Equal(t, 4, unknownWord.Index)
+	assert.Equal(t, "abandun", unknownWord.Word)
+	require.NotEmpty(t, unknownWord.Suggestions)
+	assert.Equal(t, "abandon", unknownWord.Suggestions[0])
+	assert.Contains(t, err.Error(), `did you mean "abandon"`)
+}
+
+func TestUnitMnemonicChecksumSuggestions(t *testing.T) {
+	t.Parallel()
+
+	words := strings.Fields(strings.Repeat("abandon ", 11) + "above")
+
+	_, err := NewMnemonic(words)
+	require.Error(t, err)
+
+	var checksum ErrMnemonicChecksum
+	require.True(t, errors.As(err, &checksum))
+	assert.Contains(t, checksum.Suggestions, MnemonicWordSuggestion{
+		Index:       11,
+		Word:        "above",
+		Replacement: "about",
+		Distance:    2,
+	})
+
+	for _, suggestion := range checksum.Suggestions {
+		fixed := append([]string{}, words...)
+		fixed[suggestion.Index] = suggestion.Replacement
+		_, err := NewMnemonic(fixed)
+		assert.NoError(t, err)
+	}
+
+	_, err = NewMnemonicWithLanguage(words, MnemonicLanguage(100))
+	require.Error(t, err)
+}
+
+func TestUnitMnemonicLegacyPrivateKeyWithLanguage(t *testing.T) {
+	t.Parallel()
+
+	entropy := make([]byte, 32)
+	for i := range entropy {
+		entropy[i] = byte(i * 7)
+	}
+
+	english, err := _MnemonicFromEntropy(entropy, MnemonicLanguageEnglish)
+	require.NoError(t, err)
+	expected, err := english.ToLegacyPrivateKey()
+	require.NoError(t, err)
+
+	// The legacy key only depends on the entropy, whatever the language of the words
+	for _, language := range []MnemonicLanguage{MnemonicLanguageJapanese, MnemonicLanguageFrench, MnemonicLanguageChineseSimplified} {
+		mnemonic, err := _MnemonicFromEntropy(entropy, language)
+		require.NoError(t, err)
+
+		parsed, err := MnemonicFromString(mnemonic.String())
+		require.NoError(t, err)
+		require.Equal(t, language, parsed.Language())
+
+		key, err := parsed.ToLegacyPrivateKey()
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), key.String(), language.String())
+	}
+}
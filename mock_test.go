@@ -793,7 +793,7 @@ func NewMockServer(responses []interface{}) (server *MockServer) {
 	}
 
 	go func() {
-		if err = server.server.Serve(server.listener); err != nil {
+		if err = server.server.Serve(server.listener); err != nil && err != grpc.ErrServerStopped {
 			panic(err)
 		}
 	}()
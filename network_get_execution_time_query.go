@@ -0,0 +1,180 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+)
+
+// NetworkGetExecutionTimeQuery is the query to be executed that would return the time the answering node spent
+// handling each of the given transactions. The node returns INVALID_TRANSACTION_ID if it has no execution time
+// available for any of them.
+type NetworkGetExecutionTimeQuery struct {
+	Query
+	transactionIDs []TransactionID
+}
+
+// NewNetworkGetExecutionTimeQuery creates a NetworkGetExecutionTimeQuery builder which can be used to construct and
+// execute a Network Get Execution Time Query.
+func NewNetworkGetExecutionTimeQuery() *NetworkGetExecutionTimeQuery {
+	header := services.QueryHeader{}
+	return &NetworkGetExecutionTimeQuery{
+		Query:          _NewQuery(true, &header),
+		transactionIDs: []TransactionID{},
+	}
+}
+
+// SetGrpcDeadline When execution is attempted, a single attempt will timeout when this deadline is reached. (The SDK may subsequently retry the execution.)
+func (q *NetworkGetExecutionTimeQuery) SetGrpcDeadline(deadline *time.Duration) *NetworkGetExecutionTimeQuery {
+	q.Query.SetGrpcDeadline(deadline)
+	return q
+}
+
+// SetTransactionIDs sets the IDs of the transactions for which the execution times should be retrieved.
+func (q *NetworkGetExecutionTimeQuery) SetTransactionIDs(transactionIDs ...TransactionID) *NetworkGetExecutionTimeQuery {
+	q.transactionIDs = append([]TransactionID{}, transactionIDs...)
+	return q
+}
+
+// AddTransactionID adds a transaction ID for which the execution time should be retrieved.
+func (q *NetworkGetExecutionTimeQuery) AddTransactionID(transactionID TransactionID) *NetworkGetExecutionTimeQuery {
+	q.transactionIDs = append(q.transactionIDs, transactionID)
+	return q
+}
+
+// GetTransactionIDs returns the IDs of the transactions for which the execution times will be retrieved.
+func (q *NetworkGetExecutionTimeQuery) GetTransactionIDs() []TransactionID {
+	return q.transactionIDs
+}
+
+func (q *NetworkGetExecutionTimeQuery) GetCost(client *Client) (Hbar, error) {
+	return q.Query.getCost(client, q)
+}
+
+// Execute executes the Query with the provided client. The execution times are returned in the order of the
+// transaction IDs.
+func (q *NetworkGetExecutionTimeQuery) Execute(client *Client) ([]time.Duration, error) {
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *NetworkGetExecutionTimeQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]time.Duration, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
+
+	if err != nil {
+		return []time.Duration{}, err
+	}
+
+	executionTimes := resp.GetNetworkGetExecutionTime().GetExecutionTimes()
+	durations := make([]time.Duration, 0, len(executionTimes))
+	for _, nanos := range executionTimes {
+		durations = append(durations, time.Duration(nanos))
+	}
+
+	return durations, nil
+}
+
+// SetMaxQueryPayment sets the maximum payment allowed for this Query.
+func (q *NetworkGetExecutionTimeQuery) SetMaxQueryPayment(maxPayment Hbar) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMaxQueryPayment(maxPayment)
+	return q
+}
+
+// SetQueryPayment sets the payment amount for this Query.
+func (q *NetworkGetExecutionTimeQuery) SetQueryPayment(paymentAmount Hbar) *NetworkGetExecutionTimeQuery {
+	q.Query.SetQueryPayment(paymentAmount)
+	return q
+}
+
+// SetNodeAccountIDs sets the _Node AccountID for this NetworkGetExecutionTimeQuery.
+func (q *NetworkGetExecutionTimeQuery) SetNodeAccountIDs(accountID []AccountID) *NetworkGetExecutionTimeQuery {
+	q.Query.SetNodeAccountIDs(accountID)
+	return q
+}
+
+// SetMaxRetry sets the max number of errors before execution will fail.
+func (q *NetworkGetExecutionTimeQuery) SetMaxRetry(count int) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMaxRetry(count)
+	return q
+}
+
+// SetRetryPolicy overrides the client's retry policy for this NetworkGetExecutionTimeQuery.
+func (q *NetworkGetExecutionTimeQuery) SetRetryPolicy(policy RetryPolicy) *NetworkGetExecutionTimeQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
+// SetMaxBackoff The maximum amount of time to wait between retries.
+// Every retry attempt will increase the wait time exponentially until it reaches this time.
+func (q *NetworkGetExecutionTimeQuery) SetMaxBackoff(max time.Duration) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMaxBackoff(max)
+	return q
+}
+
+// SetMinBackoff sets the minimum amount of time to wait between retries.
+func (q *NetworkGetExecutionTimeQuery) SetMinBackoff(min time.Duration) *NetworkGetExecutionTimeQuery {
+	q.Query.SetMinBackoff(min)
+	return q
+}
+
+// SetPaymentTransactionID assigns the payment transaction id.
+func (q *NetworkGetExecutionTimeQuery) SetPaymentTransactionID(transactionID TransactionID) *NetworkGetExecutionTimeQuery {
+	q.Query.SetPaymentTransactionID(transactionID)
+	return q
+}
+
+func (q *NetworkGetExecutionTimeQuery) SetLogLevel(level LogLevel) *NetworkGetExecutionTimeQuery {
+	q.Query.SetLogLevel(level)
+	return q
+}
+
+// ---------- Parent functions specific implementation ----------
+
+func (q *NetworkGetExecutionTimeQuery) getMethod(channel *_Channel) _Method {
+	return _Method{
+		query: channel._GetNetwork().GetExecutionTime,
+	}
+}
+
+func (q *NetworkGetExecutionTimeQuery) getName() string {
+	return "NetworkGetExecutionTimeQuery"
+}
+
+func (q *NetworkGetExecutionTimeQuery) buildQuery() *services.Query {
+	pb := services.Query_NetworkGetExecutionTime{
+		NetworkGetExecutionTime: &services.NetworkGetExecutionTimeQuery{
+			Header: q.pbHeader,
+		},
+	}
+
+	for _, transactionID := range q.transactionIDs {
+		pb.NetworkGetExecutionTime.TransactionIds = append(pb.NetworkGetExecutionTime.TransactionIds, transactionID._ToProtobuf())
+	}
+
+	return &services.Query{
+		Query: &pb,
+	}
+}
+
+func (q *NetworkGetExecutionTimeQuery) validateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	for _, transactionID := range q.transactionIDs {
+		if transactionID.AccountID != nil {
+			if err := transactionID.AccountID.ValidateChecksum(client); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (q *NetworkGetExecutionTimeQuery) getQueryResponse(response *services.Response) queryResponse {
+	return response.GetNetworkGetExecutionTime()
+}
@@ -0,0 +1,135 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitNetworkGetExecutionTimeQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	client, err := _NewMockClient()
+	client.SetLedgerID(*NewLedgerIDTestnet())
+	require.NoError(t, err)
+	client.SetAutoValidateChecksums(true)
+
+	accountID, err := AccountIDFromString("0.0.123-esxsf")
+	require.NoError(t, err)
+	err = NewNetworkGetExecutionTimeQuery().
+		SetTransactionIDs(TransactionIDGenerate(accountID)).
+		validateNetworkOnIDs(client)
+	require.NoError(t, err)
+
+	accountID, err = AccountIDFromString("0.0.123-rmkykd")
+	require.NoError(t, err)
+	err = NewNetworkGetExecutionTimeQuery().
+		SetTransactionIDs(TransactionIDGenerate(accountID)).
+		validateNetworkOnIDs(client)
+	assert.EqualError(t, err, "network mismatch or wrong checksum given, given checksum: rmkykd, correct checksum esxsf, network: testnet")
+}
+
+func TestUnitNetworkGetExecutionTimeQueryGet(t *testing.T) {
+	t.Parallel()
+
+	first := TransactionIDGenerate(AccountID{Account: 1800})
+	second := TransactionIDGenerate(AccountID{Account: 1801})
+	deadline := time.Minute
+
+	query := NewNetworkGetExecutionTimeQuery().
+		SetTransactionIDs(first).
+		AddTransactionID(second).
+		SetQueryPayment(NewHbar(2)).
+		SetMaxQueryPayment(NewHbar(10)).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetMaxRetry(5).
+		SetGrpcDeadline(&deadline)
+
+	require.Equal(t, []TransactionID{first, second}, query.GetTransactionIDs())
+	require.Equal(t, NewHbar(2), query.GetQueryPayment())
+	require.Equal(t, NewHbar(10), query.GetMaxQueryPayment())
+	require.Equal(t, []AccountID{{Account: 3}}, query.GetNodeAccountIDs())
+	require.Equal(t, 5, query.GetMaxRetryCount())
+	require.Equal(t, &deadline, query.GetGrpcDeadline())
+	require.Equal(t, "NetworkGetExecutionTimeQuery", query.getName())
+
+	require.Empty(t, NewNetworkGetExecutionTimeQuery().GetTransactionIDs())
+}
+
+func TestUnitNetworkGetExecutionTimeQueryMock(t *testing.T) {
+	t.Parallel()
+
+	first := TransactionIDGenerate(AccountID{Account: 1800})
+	second := TransactionIDGenerate(AccountID{Account: 1801})
+
+	call := func(request *services.Query) *services.Response {
+		query := request.GetNetworkGetExecutionTime()
+		require.Len(t, query.GetTransactionIds(), 2)
+		require.Equal(t, first.String(), _TransactionIDFromProtobuf(query.GetTransactionIds()[0]).String())
+		require.Equal(t, second.String(), _TransactionIDFromProtobuf(query.GetTransactionIds()[1]).String())
+
+		responseType := services.ResponseType_ANSWER_ONLY
+		if query.GetHeader().GetResponseType() == services.ResponseType_COST_ANSWER {
+			responseType = services.ResponseType_COST_ANSWER
+		}
+
+		return &services.Response{
+			Response: &services.Response_NetworkGetExecutionTime{
+				NetworkGetExecutionTime: &services.NetworkGetExecutionTimeResponse{
+					Header:         &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: responseType, Cost: 2},
+					ExecutionTimes: []uint64{1_500, 2_000_000},
+				},
+			},
+		}
+	}
+
+	responses := [][]interface{}{{call, call, call}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	query := NewNetworkGetExecutionTimeQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionIDs(first, second).
+		SetMaxQueryPayment(NewHbar(1))
+
+	cost, err := query.GetCost(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(2), cost)
+
+	durations, err := query.Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{1500 * time.Nanosecond, 2 * time.Millisecond}, durations)
+}
+
+func TestUnitNetworkGetExecutionTimeQueryInvalidTransactionID(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_NetworkGetExecutionTime{
+				NetworkGetExecutionTime: &services.NetworkGetExecutionTimeResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_INVALID_TRANSACTION_ID, ResponseType: services.ResponseType_ANSWER_ONLY},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, err := NewNetworkGetExecutionTimeQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionIDs(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetQueryPayment(NewHbar(1)).
+		Execute(client)
+	require.ErrorContains(t, err, "INVALID_TRANSACTION_ID")
+}
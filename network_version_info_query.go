@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -34,7 +35,12 @@ func (q *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *NetworkVersionInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (NetworkVersionInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return NetworkVersionInfo{}, err
@@ -67,6 +73,12 @@ func (q *NetworkVersionInfoQuery) SetMaxRetry(count int) *NetworkVersionInfoQuer
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this NetworkVersionInfoQuery.
+func (q *NetworkVersionInfoQuery) SetRetryPolicy(policy RetryPolicy) *NetworkVersionInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *NetworkVersionInfoQuery) SetMaxBackoff(max time.Duration) *NetworkVersionInfoQuery {
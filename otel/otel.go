@@ -0,0 +1,262 @@
+// Package otel records OpenTelemetry traces and metrics for transactions and queries executed by a hiero.Client.
+//
+// Every execution produces a span named after the request type, with a child span per node attempt. Latency,
+// per-node errors, backoff durations and query payments are recorded as metrics.
+//
+// The package only depends on the OpenTelemetry API; the application decides which SDK and exporters to use. It is a
+// module of its own, github.com/hiero-ledger/hiero-sdk-go/otel, so that applications not using it don't depend on
+// OpenTelemetry.
+//
+//	err := otel.Instrument(client, otel.WithTracerProvider(tp), otel.WithMeterProvider(mp))
+package otel
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"sync"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	global "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+)
+
+// InstrumentationName is the name of the tracer and meter used by this package.
+const InstrumentationName = "github.com/hiero-ledger/hiero-sdk-go/otel"
+
+// Attribute keys set on spans and metrics.
+const (
+	AttributeRequestType   = attribute.Key("hiero.request.type")
+	AttributeTransactionID = attribute.Key("hiero.transaction.id")
+	AttributeNodeAccountID = attribute.Key("hiero.node.account_id")
+	AttributeStatus        = attribute.Key("hiero.status")
+	AttributeAttempt       = attribute.Key("hiero.attempt")
+	AttributeAttempts      = attribute.Key("hiero.attempts")
+	AttributeGrpcCode      = attribute.Key("rpc.grpc.status_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the interceptor returned by NewInterceptor.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider spans are created with. The global provider is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider metrics are recorded with. The global provider is used by default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+type _AttemptKey struct {
+	ctx     context.Context
+	attempt int64
+}
+
+type _Instrumentation struct {
+	tracer trace.Tracer
+
+	requestDuration metric.Float64Histogram
+	attemptDuration metric.Float64Histogram
+	nodeErrors      metric.Int64Counter
+	backoffDuration metric.Float64Histogram
+	queryPayment    metric.Int64Histogram
+
+	attemptSpans sync.Map // _AttemptKey -> trace.Span
+}
+
+// Instrument registers an interceptor created with NewInterceptor on the client.
+func Instrument(client *hiero.Client, options ...Option) error {
+	interceptor, err := NewInterceptor(options...)
+	if err != nil {
+		return err
+	}
+
+	client.AddInterceptor(interceptor)
+	return nil
+}
+
+// NewInterceptor creates a hiero.Interceptor that records spans and metrics for every execution.
+func NewInterceptor(options ...Option) (hiero.Interceptor, error) {
+	c := config{}
+	for _, option := range options {
+		option(&c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = global.GetTracerProvider()
+	}
+	if c.meterProvider == nil {
+		c.meterProvider = global.GetMeterProvider()
+	}
+
+	meter := c.meterProvider.Meter(InstrumentationName)
+	instrumentation := &_Instrumentation{
+		tracer: c.tracerProvider.Tracer(InstrumentationName),
+	}
+
+	var err error
+	if instrumentation.requestDuration, err = meter.Float64Histogram(
+		"hiero.request.duration",
+		metric.WithDescription("Duration of a transaction or query execution, including retries and backoff"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return hiero.Interceptor{}, err
+	}
+	if instrumentation.attemptDuration, err = meter.Float64Histogram(
+		"hiero.attempt.duration",
+		metric.WithDescription("Latency of a single gRPC call to a node"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return hiero.Interceptor{}, err
+	}
+	if instrumentation.nodeErrors, err = meter.Int64Counter(
+		"hiero.node.errors",
+		metric.WithDescription("Attempts that failed with a gRPC error or a status other than OK, per node"),
+		metric.WithUnit("{error}"),
+	); err != nil {
+		return hiero.Interceptor{}, err
+	}
+	if instrumentation.backoffDuration, err = meter.Float64Histogram(
+		"hiero.backoff.duration",
+		metric.WithDescription("Time spent waiting before retrying a request"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return hiero.Interceptor{}, err
+	}
+	if instrumentation.queryPayment, err = meter.Int64Histogram(
+		"hiero.query.payment",
+		metric.WithDescription("Payment attached to paid queries"),
+		metric.WithUnit("{tinybar}"),
+	); err != nil {
+		return hiero.Interceptor{}, err
+	}
+
+	return hiero.Interceptor{
+		OnExecuteStart:  instrumentation.onExecuteStart,
+		OnExecuteEnd:    instrumentation.onExecuteEnd,
+		OnAttempt:       instrumentation.onAttempt,
+		OnResponse:      instrumentation.onResponse,
+		OnBackoff:       instrumentation.onBackoff,
+		OnNodeUnhealthy: instrumentation.onNodeUnhealthy,
+	}, nil
+}
+
+func (instrumentation *_Instrumentation) onExecuteStart(event hiero.ExecuteEvent) context.Context {
+	ctx, _ := instrumentation.tracer.Start(
+		event.Context,
+		event.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttributeRequestType.String(event.Name),
+			AttributeTransactionID.String(event.TransactionID),
+		),
+	)
+
+	return ctx
+}
+
+func (instrumentation *_Instrumentation) onExecuteEnd(event hiero.ExecuteEndEvent) {
+	requestType := AttributeRequestType.String(event.Name)
+	statusAttribute := AttributeStatus.String(event.Status.String())
+
+	span := trace.SpanFromContext(event.Context)
+	span.SetAttributes(
+		AttributeNodeAccountID.String(event.NodeAccountID.String()),
+		AttributeAttempts.Int64(event.Attempts),
+		statusAttribute,
+	)
+	if event.Err != nil {
+		span.RecordError(event.Err)
+		span.SetStatus(codes.Error, event.Err.Error())
+	}
+	span.End()
+
+	instrumentation.requestDuration.Record(
+		event.Context,
+		event.Duration.Seconds(),
+		metric.WithAttributes(requestType, statusAttribute),
+	)
+
+	if tinybars := event.QueryPayment.AsTinybar(); tinybars > 0 {
+		instrumentation.queryPayment.Record(event.Context, tinybars, metric.WithAttributes(requestType))
+	}
+}
+
+func (instrumentation *_Instrumentation) onAttempt(event hiero.AttemptEvent) {
+	_, span := instrumentation.tracer.Start(
+		event.Context,
+		event.Name+" attempt",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttributeRequestType.String(event.Name),
+			AttributeTransactionID.String(event.TransactionID),
+			AttributeNodeAccountID.String(event.NodeAccountID.String()),
+			AttributeAttempt.Int64(event.Attempt),
+		),
+	)
+
+	instrumentation.attemptSpans.Store(_AttemptKey{event.Context, event.Attempt}, span)
+}
+
+func (instrumentation *_Instrumentation) onResponse(event hiero.ResponseEvent) {
+	attributes := []attribute.KeyValue{
+		AttributeRequestType.String(event.Name),
+		AttributeNodeAccountID.String(event.NodeAccountID.String()),
+	}
+	if event.Err != nil {
+		attributes = append(attributes, AttributeGrpcCode.String(status.Code(event.Err).String()))
+	} else {
+		attributes = append(attributes, AttributeStatus.String(event.Status.String()))
+	}
+
+	if value, ok := instrumentation.attemptSpans.LoadAndDelete(_AttemptKey{event.Context, event.Attempt}); ok {
+		span := value.(trace.Span)
+		span.SetAttributes(attributes...)
+		if event.Err != nil {
+			span.RecordError(event.Err)
+			span.SetStatus(codes.Error, event.Err.Error())
+		} else if event.Status != hiero.StatusOk && event.Status != hiero.StatusSuccess {
+			span.SetStatus(codes.Error, event.Status.String())
+		}
+		span.End()
+	}
+
+	instrumentation.attemptDuration.Record(event.Context, event.Latency.Seconds(), metric.WithAttributes(attributes...))
+
+	if event.Err != nil || (event.Status != hiero.StatusOk && event.Status != hiero.StatusSuccess) {
+		instrumentation.nodeErrors.Add(event.Context, 1, metric.WithAttributes(attributes...))
+	}
+}
+
+func (instrumentation *_Instrumentation) onBackoff(event hiero.BackoffEvent) {
+	trace.SpanFromContext(event.Context).AddEvent("backoff", trace.WithAttributes(
+		AttributeAttempt.Int64(event.Attempt),
+		attribute.String("hiero.backoff.delay", event.Delay.String()),
+	))
+
+	instrumentation.backoffDuration.Record(
+		event.Context,
+		event.Delay.Seconds(),
+		metric.WithAttributes(AttributeRequestType.String(event.Name)),
+	)
+}
+
+func (instrumentation *_Instrumentation) onNodeUnhealthy(event hiero.NodeUnhealthyEvent) {
+	trace.SpanFromContext(event.Context).AddEvent("node unhealthy", trace.WithAttributes(
+		AttributeNodeAccountID.String(event.NodeAccountID.String()),
+		attribute.String("hiero.node.address", event.Address),
+	))
+}
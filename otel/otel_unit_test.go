@@ -0,0 +1,220 @@
+//go:build all || unit
+// +build all unit
+
+package otel
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hiero "github.com/hiero-ledger/hiero-sdk-go/v2"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func _NewTestInterceptor(t *testing.T) (hiero.Interceptor, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	interceptor, err := NewInterceptor(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	require.NoError(t, err)
+
+	return interceptor, spans, reader
+}
+
+func _AttributeValue(attributes []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, kv := range attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+func _FindMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Aggregation {
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+
+	require.Failf(t, "metric not found", "%s was not recorded", name)
+	return nil
+}
+
+func TestUnitOtelSpans(t *testing.T) {
+	t.Parallel()
+
+	interceptor, spans, _ := _NewTestInterceptor(t)
+	node3 := hiero.AccountID{Account: 3}
+	node4 := hiero.AccountID{Account: 4}
+
+	start := hiero.ExecuteEvent{
+		Context:       context.Background(),
+		Name:          "TransferTransaction",
+		TransactionID: "0.0.1800@1700000000.000000000",
+	}
+	ctx := interceptor.OnExecuteStart(start)
+	require.True(t, trace.SpanContextFromContext(ctx).IsValid())
+
+	first := hiero.RequestInfo{Context: ctx, Name: start.Name, TransactionID: start.TransactionID, NodeAccountID: node3, Attempt: 0}
+	interceptor.OnAttempt(hiero.AttemptEvent{RequestInfo: first})
+	interceptor.OnResponse(hiero.ResponseEvent{RequestInfo: first, Status: hiero.StatusBusy, Latency: time.Millisecond})
+	interceptor.OnBackoff(hiero.BackoffEvent{RequestInfo: first, Delay: 250 * time.Millisecond})
+
+	second := first
+	second.NodeAccountID = node4
+	second.Attempt = 1
+	interceptor.OnAttempt(hiero.AttemptEvent{RequestInfo: second})
+	interceptor.OnResponse(hiero.ResponseEvent{RequestInfo: second, Status: hiero.StatusOk, Latency: time.Millisecond})
+
+	start.Context = ctx
+	interceptor.OnExecuteEnd(hiero.ExecuteEndEvent{
+		ExecuteEvent:  start,
+		Attempts:      2,
+		NodeAccountID: node4,
+		Status:        hiero.StatusOk,
+		Duration:      300 * time.Millisecond,
+	})
+
+	ended := spans.Ended()
+	require.Len(t, ended, 3)
+
+	parent := ended[2]
+	require.Equal(t, "TransferTransaction", parent.Name())
+	require.Equal(t, trace.SpanKindClient, parent.SpanKind())
+	require.Equal(t, start.TransactionID, _AttributeValue(parent.Attributes(), AttributeTransactionID).AsString())
+	require.Equal(t, "0.0.4", _AttributeValue(parent.Attributes(), AttributeNodeAccountID).AsString())
+	require.Equal(t, int64(2), _AttributeValue(parent.Attributes(), AttributeAttempts).AsInt64())
+	require.Equal(t, "OK", _AttributeValue(parent.Attributes(), AttributeStatus).AsString())
+	require.Equal(t, codes.Unset, parent.Status().Code)
+	require.Len(t, parent.Events(), 1)
+	require.Equal(t, "backoff", parent.Events()[0].Name)
+
+	for i, attempt := range ended[:2] {
+		require.Equal(t, "TransferTransaction attempt", attempt.Name())
+		require.Equal(t, parent.SpanContext().SpanID(), attempt.Parent().SpanID())
+		require.Equal(t, int64(i), _AttributeValue(attempt.Attributes(), AttributeAttempt).AsInt64())
+	}
+	require.Equal(t, "0.0.3", _AttributeValue(ended[0].Attributes(), AttributeNodeAccountID).AsString())
+	require.Equal(t, "BUSY", _AttributeValue(ended[0].Attributes(), AttributeStatus).AsString())
+	require.Equal(t, codes.Error, ended[0].Status().Code)
+	require.Equal(t, codes.Unset, ended[1].Status().Code)
+}
+
+func TestUnitOtelFailedExecution(t *testing.T) {
+	t.Parallel()
+
+	interceptor, spans, reader := _NewTestInterceptor(t)
+
+	start := hiero.ExecuteEvent{Context: context.Background(), Name: "AccountBalanceQuery"}
+	ctx := interceptor.OnExecuteStart(start)
+
+	info := hiero.RequestInfo{Context: ctx, Name: start.Name, NodeAccountID: hiero.AccountID{Account: 3}}
+	interceptor.OnAttempt(hiero.AttemptEvent{RequestInfo: info})
+	interceptor.OnResponse(hiero.ResponseEvent{RequestInfo: info, Err: status.Error(grpcCodes.Unavailable, "node is down")})
+	interceptor.OnNodeUnhealthy(hiero.NodeUnhealthyEvent{RequestInfo: info, Address: "127.0.0.1:50211"})
+
+	start.Context = ctx
+	interceptor.OnExecuteEnd(hiero.ExecuteEndEvent{
+		ExecuteEvent: start,
+		Attempts:     1,
+		Err:          errors.New("exceptional precheck status"),
+	})
+
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+	require.Equal(t, codes.Error, ended[0].Status().Code)
+	require.Equal(t, "Unavailable", _AttributeValue(ended[0].Attributes(), AttributeGrpcCode).AsString())
+	require.Equal(t, codes.Error, ended[1].Status().Code)
+	require.Equal(t, "node unhealthy", ended[1].Events()[0].Name)
+
+	errorsData, ok := _FindMetric(t, reader, "hiero.node.errors").(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, errorsData.DataPoints, 1)
+	require.Equal(t, int64(1), errorsData.DataPoints[0].Value)
+	node, _ := errorsData.DataPoints[0].Attributes.Value(AttributeNodeAccountID)
+	require.Equal(t, "0.0.3", node.AsString())
+}
+
+func TestUnitOtelMetrics(t *testing.T) {
+	t.Parallel()
+
+	interceptor, _, reader := _NewTestInterceptor(t)
+
+	start := hiero.ExecuteEvent{Context: context.Background(), Name: "AccountInfoQuery"}
+	ctx := interceptor.OnExecuteStart(start)
+
+	info := hiero.RequestInfo{Context: ctx, Name: start.Name, NodeAccountID: hiero.AccountID{Account: 3}}
+	interceptor.OnAttempt(hiero.AttemptEvent{RequestInfo: info})
+	interceptor.OnResponse(hiero.ResponseEvent{RequestInfo: info, Status: hiero.StatusBusy, Latency: 10 * time.Millisecond})
+	interceptor.OnBackoff(hiero.BackoffEvent{RequestInfo: info, Delay: 500 * time.Millisecond})
+
+	info.Attempt = 1
+	interceptor.OnAttempt(hiero.AttemptEvent{RequestInfo: info})
+	interceptor.OnResponse(hiero.ResponseEvent{RequestInfo: info, Status: hiero.StatusOk, Latency: 20 * time.Millisecond})
+
+	start.Context = ctx
+	interceptor.OnExecuteEnd(hiero.ExecuteEndEvent{
+		ExecuteEvent:  start,
+		Attempts:      2,
+		NodeAccountID: hiero.AccountID{Account: 3},
+		Status:        hiero.StatusOk,
+		QueryPayment:  hiero.HbarFromTinybar(25),
+		Duration:      time.Second,
+	})
+
+	requestData, ok := _FindMetric(t, reader, "hiero.request.duration").(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, requestData.DataPoints, 1)
+	require.Equal(t, uint64(1), requestData.DataPoints[0].Count)
+	require.Equal(t, 1.0, requestData.DataPoints[0].Sum)
+
+	attemptData, ok := _FindMetric(t, reader, "hiero.attempt.duration").(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, attemptData.DataPoints, 2)
+
+	backoffData, ok := _FindMetric(t, reader, "hiero.backoff.duration").(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, backoffData.DataPoints, 1)
+	require.Equal(t, 0.5, backoffData.DataPoints[0].Sum)
+
+	paymentData, ok := _FindMetric(t, reader, "hiero.query.payment").(metricdata.Histogram[int64])
+	require.True(t, ok)
+	require.Len(t, paymentData.DataPoints, 1)
+	require.Equal(t, int64(25), paymentData.DataPoints[0].Sum)
+
+	nodeErrors, ok := _FindMetric(t, reader, "hiero.node.errors").(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, nodeErrors.DataPoints, 1)
+}
+
+func TestUnitOtelInstrument(t *testing.T) {
+	t.Parallel()
+
+	client := hiero.ClientForTestnet()
+	defer client.Close()
+
+	require.NoError(t, Instrument(client))
+}
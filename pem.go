@@ -0,0 +1,298 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha1" // nolint
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"hash"
+
+	"github.com/youmark/pkcs8"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const pemPrivateKeyType = "PRIVATE KEY"
+const pemEncryptedPrivateKeyType = "ENCRYPTED PRIVATE KEY"
+const pemPublicKeyType = "PUBLIC KEY"
+
+var (
+	oidEd25519          = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidECPublicKey      = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1        = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidPBES2            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1     = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+	oidAES128CBC        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	pemEncryptionCipher = pkcs8.AES256CBC
+)
+
+// internal struct for a PKCS#8 PrivateKeyInfo
+type _PrivateKeyInfo struct {
+	Version             int
+	PrivateKeyAlgorithm pkix.AlgorithmIdentifier
+	PrivateKey          []byte
+}
+
+// internal struct for a PKCS#8 EncryptedPrivateKeyInfo
+type _EncryptedPrivateKeyInfo struct {
+	EncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedData       []byte
+}
+
+// internal struct for the parameters of the PBES2 encryption scheme of RFC 8018
+type _PBES2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// internal struct for the parameters of PBKDF2; a missing PRF means HMAC-SHA1
+type _PBKDF2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// _PemEncode encodes a DER key as a PEM block. If a passphrase is given, the key is encrypted with PBES2 using
+// PBKDF2-HMAC-SHA256 and AES-256-CBC, as OpenSSL does by default.
+func _PemEncode(pkcs8Der []byte, passphrase string) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return pem.EncodeToMemory(&pem.Block{Type: pemPrivateKeyType, Bytes: pkcs8Der}), nil
+	}
+
+	encrypted, err := _EncryptPKCS8(pkcs8Der, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemEncryptedPrivateKeyType, Bytes: encrypted}), nil
+}
+
+func _EncryptPKCS8(pkcs8Der []byte, passphrase string) ([]byte, error) {
+	kdfOpts := pkcs8.DefaultOpts.KDFOpts
+
+	salt := make([]byte, kdfOpts.GetSaltSize())
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, pemEncryptionCipher.IVSize())
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	key, kdfParams, err := kdfOpts.DeriveKey([]byte(passphrase), salt, pemEncryptionCipher.KeySize())
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := pemEncryptionCipher.Encrypt(key, iv, pkcs8Der)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalledKdfParams, err := asn1.Marshal(kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalledIV, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := asn1.Marshal(_PBES2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{
+			Algorithm:  kdfOpts.OID(),
+			Parameters: asn1.RawValue{FullBytes: marshalledKdfParams},
+		},
+		EncryptionScheme: pkix.AlgorithmIdentifier{
+			Algorithm:  pemEncryptionCipher.OID(),
+			Parameters: asn1.RawValue{FullBytes: marshalledIV},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(_EncryptedPrivateKeyInfo{
+		EncryptionAlgorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPBES2,
+			Parameters: asn1.RawValue{FullBytes: params},
+		},
+		EncryptedData: encrypted,
+	})
+}
+
+// _DecryptPKCS8 decrypts a PKCS#8 EncryptedPrivateKeyInfo protected with PBES2, PBKDF2 and AES-CBC. Unlike
+// pkcs8.ParsePKCS8PrivateKey, it returns the decrypted DER, so that it also works for keys on curves that crypto/x509
+// does not support, such as secp256k1.
+func _DecryptPKCS8(der []byte, passphrase string) ([]byte, error) {
+	var info _EncryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, err
+	}
+
+	if !info.EncryptionAlgorithm.Algorithm.Equal(oidPBES2) {
+		return nil, _NewErrBadKeyf("unsupported PKCS#8 encryption: %v", info.EncryptionAlgorithm.Algorithm)
+	}
+
+	var params _PBES2Params
+	if _, err := asn1.Unmarshal(info.EncryptionAlgorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, err
+	}
+
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, _NewErrBadKeyf("unsupported KDF: %v", params.KeyDerivationFunc.Algorithm)
+	}
+
+	var kdfParams _PBKDF2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil {
+		return nil, err
+	}
+
+	if count := kdfParams.IterationCount; count < 1 || count > maxPbkdf2Count {
+		return nil, _NewErrBadKeyf("pbkdf2 iteration count must be between 1 and %v, got %v", maxPbkdf2Count, count)
+	}
+
+	var prf func() hash.Hash
+	switch {
+	case len(kdfParams.PRF.Algorithm) == 0, kdfParams.PRF.Algorithm.Equal(oidHMACWithSHA1):
+		prf = sha1.New
+	case kdfParams.PRF.Algorithm.Equal(oidHMACWithSHA256):
+		prf = sha256.New
+	case kdfParams.PRF.Algorithm.Equal(oidHMACWithSHA384):
+		prf = sha512.New384
+	case kdfParams.PRF.Algorithm.Equal(oidHMACWithSHA512):
+		prf = sha512.New
+	default:
+		return nil, _NewErrBadKeyf("unsupported PRF: %v", kdfParams.PRF.Algorithm)
+	}
+
+	var keyLength int
+	switch {
+	case params.EncryptionScheme.Algorithm.Equal(oidAES128CBC):
+		keyLength = 16
+	case params.EncryptionScheme.Algorithm.Equal(oidAES192CBC):
+		keyLength = 24
+	case params.EncryptionScheme.Algorithm.Equal(oidAES256CBC):
+		keyLength = 32
+	default:
+		return nil, _NewErrBadKeyf("unsupported PKCS#8 cipher: %v", params.EncryptionScheme.Algorithm)
+	}
+
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+
+	if len(iv) != aes.BlockSize || len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, _NewErrBadKeyf("invalid PKCS#8 encrypted data")
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), kdfParams.Salt, kdfParams.IterationCount, keyLength, prf)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, info.EncryptedData)
+
+	// PKCS#7 padding; a wrong passphrase almost always leaves invalid padding behind
+	padding := int(decrypted[len(decrypted)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, _NewErrBadKeyf("pkcs8: incorrect password")
+	}
+	for _, b := range decrypted[len(decrypted)-padding:] {
+		if int(b) != padding {
+			return nil, _NewErrBadKeyf("pkcs8: incorrect password")
+		}
+	}
+
+	return decrypted[:len(decrypted)-padding], nil
+}
+
+// _PrivateKeyFromPKCS8Pem reads an Ed25519 or ECDSA secp256k1 key from the first PKCS#8 block of a PEM file, decrypting
+// it first if it is encrypted. found is false if the file has no PKCS#8 block.
+func _PrivateKeyFromPKCS8Pem(bytes []byte, passphrase string) (PrivateKey, bool, error) {
+	for block, rest := pem.Decode(bytes); block != nil; block, rest = pem.Decode(rest) {
+		der := block.Bytes
+		switch block.Type {
+		case pemPrivateKeyType:
+		case pemEncryptedPrivateKeyType:
+			var err error
+			if der, err = _DecryptPKCS8(der, passphrase); err != nil {
+				return PrivateKey{}, true, err
+			}
+		default:
+			continue
+		}
+
+		var info _PrivateKeyInfo
+		if _, err := asn1.Unmarshal(der, &info); err != nil {
+			return PrivateKey{}, true, err
+		}
+
+		switch {
+		case info.PrivateKeyAlgorithm.Algorithm.Equal(oidEd25519):
+			var seed []byte
+			if _, err := asn1.Unmarshal(info.PrivateKey, &seed); err != nil {
+				return PrivateKey{}, true, err
+			}
+			if len(seed) != ed25519.SeedSize {
+				return PrivateKey{}, true, _NewErrBadKeyf("invalid private key length: %v bytes", len(seed))
+			}
+
+			ed25519Key, err := _Ed25519PrivateKeyFromBytesRaw(seed)
+			return PrivateKey{ed25519PrivateKey: ed25519Key}, true, err
+		case info.PrivateKeyAlgorithm.Algorithm.Equal(oidECPublicKey):
+			var curve asn1.ObjectIdentifier
+			if _, err := asn1.Unmarshal(info.PrivateKeyAlgorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidSecp256k1) {
+				return PrivateKey{}, true, _NewErrBadKeyf("pem file does not contain an ECDSA secp256k1 key")
+			}
+
+			ecdsaKey, err := _ECDSAPrivateKeyFromBytesDer(info.PrivateKey)
+			return PrivateKey{ecdsaPrivateKey: ecdsaKey}, true, err
+		default:
+			return PrivateKey{}, true, _NewErrBadKeyf("unsupported PKCS#8 key algorithm: %v", info.PrivateKeyAlgorithm.Algorithm)
+		}
+	}
+
+	return PrivateKey{}, false, nil
+}
+
+// _BytesPKCS8 returns the _ECDSAPrivateKey as a PKCS#8 PrivateKeyInfo holding its SEC 1 encoding
+func (sk _ECDSAPrivateKey) _BytesPKCS8() ([]byte, error) {
+	curve, err := asn1.Marshal(oidSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(_PrivateKeyInfo{
+		Version: 0,
+		PrivateKeyAlgorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  oidECPublicKey,
+			Parameters: asn1.RawValue{FullBytes: curve},
+		},
+		PrivateKey: sk._BytesDer(),
+	})
+}
+
+// _PemEncodePublicKey encodes a DER SubjectPublicKeyInfo as a PEM block
+func _PemEncodePublicKey(spkiDer []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: pemPublicKeyType, Bytes: spkiDer})
+}
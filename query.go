@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -84,6 +85,10 @@ func (q *Query) GetQueryPayment() Hbar {
 
 // GetCost returns the fee that would be charged to get the requested information (if a cost was requested).
 func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
+	return q.getCostWithContext(context.Background(), client, e)
+}
+
+func (q *Query) getCostWithContext(ctx context.Context, client *Client, e QueryInterface) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -107,7 +112,7 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 
 	q.pbHeader.ResponseType = services.ResponseType_COST_ANSWER
 	q.paymentTransactionIDs._Advance()
-	resp, err := _Execute(client, e)
+	resp, err := _Execute(ctx, client, e)
 
 	if err != nil {
 		return Hbar{}, err
@@ -119,7 +124,7 @@ func (q *Query) getCost(client *Client, e QueryInterface) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
+func _QueryMakePaymentTransaction(ctx context.Context, transactionID TransactionID, nodeAccountID AccountID, operator *_Operator, cost Hbar) (*services.Transaction, error) {
 	accountAmounts := make([]*services.AccountAmount, 0)
 	accountAmounts = append(accountAmounts, &services.AccountAmount{
 		AccountID: nodeAccountID._ToProtobuf(),
@@ -151,9 +156,9 @@ func _QueryMakePaymentTransaction(transactionID TransactionID, nodeAccountID Acc
 		return nil, errors.Wrap(err, "error serializing Query body")
 	}
 
-	signature := operator.signer(bodyBytes)
-	if len(signature) == 65 {
-		signature = signature[1:]
+	signature, err := _SignerSign(ctx, operator.signer, bodyBytes)
+	if err != nil {
+		return nil, err
 	}
 	sigPairs := make([]*services.SignaturePair, 0)
 	sigPairs = append(sigPairs, operator.publicKey._ToSignaturePairProtobuf(signature))
@@ -180,6 +185,12 @@ func (q *Query) GetMaxRetryCount() int {
 	return q.GetMaxRetry()
 }
 
+// SetRetryPolicy overrides the client's retry policy for this query.
+func (q *Query) SetRetryPolicy(policy RetryPolicy) *Query {
+	q.executable.SetRetryPolicy(policy)
+	return q
+}
+
 // SetPaymentTransactionID assigns the payment transaction id.
 func (q *Query) SetPaymentTransactionID(transactionID TransactionID) *Query {
 	q.paymentTransactionIDs._Clear()._Push(transactionID)._SetLocked(true)
@@ -187,11 +198,27 @@ func (q *Query) SetPaymentTransactionID(transactionID TransactionID) *Query {
 }
 
 func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, error) {
+	return q.executeWithContext(context.Background(), client, e)
+}
+
+func (q *Query) executeWithContext(ctx context.Context, client *Client, e QueryInterface) (*services.Response, error) {
 	q.client = client
 	if client == nil {
 		return nil, errNoClientProvided
 	}
 
+	// The cost query of a paid query is part of the same execution for interceptors
+	resp, err := _ExecuteScoped(ctx, client, e, func(ctx context.Context) (interface{}, error) {
+		return q._ExecuteWithCost(ctx, client, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*services.Response), nil
+}
+
+func (q *Query) _ExecuteWithCost(ctx context.Context, client *Client, e QueryInterface) (*services.Response, error) {
 	var err error
 
 	err = e.validateNetworkOnIDs(client)
@@ -207,7 +234,7 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 			cost = q.maxQueryPayment
 		}
 
-		actualCost, err := q.getCost(client, e)
+		actualCost, err := q.getCostWithContext(ctx, client, e)
 		if err != nil {
 			return nil, err
 		}
@@ -231,7 +258,7 @@ func (q *Query) execute(client *Client, e QueryInterface) (*services.Response, e
 	q.pb = e.buildQuery()
 	q.pbHeader.ResponseType = services.ResponseType_ANSWER_ONLY
 
-	resp, err := _Execute(client, e)
+	resp, err := _Execute(ctx, client, e)
 	if err != nil {
 		return nil, err
 	}
@@ -261,12 +288,13 @@ func (q *Query) shouldRetry(e Executable, response interface{}) _ExecutionState
 	return executionStateError
 }
 
-func (q *Query) generatePayments(client *Client, cost Hbar) (*services.Transaction, error) {
+func (q *Query) generatePayments(ctx context.Context, client *Client, cost Hbar) (*services.Transaction, error) {
 	var tx *services.Transaction
 	var err error
 	for _, nodeID := range q.nodeAccountIDs.slice {
 		txnID := TransactionIDGenerate(client.operator.accountID)
 		tx, err = _QueryMakePaymentTransaction(
+			ctx,
 			txnID,
 			nodeID.(AccountID),
 			client.operator,
@@ -284,16 +312,16 @@ func (q *Query) advanceRequest() {
 	q.nodeAccountIDs._Advance()
 }
 
-func (q *Query) makeRequest() interface{} {
+func (q *Query) makeRequest(ctx context.Context) (interface{}, error) {
 	if q.client != nil && q.isPaymentRequired {
-		tx, err := q.generatePayments(q.client, q.queryPayment)
+		tx, err := q.generatePayments(ctx, q.client, q.queryPayment)
 		if err != nil {
-			return q.pb
+			return q.pb, err
 		}
 		q.pbHeader.Payment = tx
 	}
 
-	return q.pb
+	return q.pb, nil
 }
 
 func (q *Query) mapResponse(response interface{}, _ AccountID, _ interface{}) (interface{}, error) { // nolint
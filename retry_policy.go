@@ -0,0 +1,205 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy decides how a transaction or query reacts to a failed attempt: whether to try again, how long to
+// wait first and whether the next attempt should be sent to a different node.
+// A policy can be set on the Client with SetRetryPolicy and overridden per transaction or query.
+type RetryPolicy interface {
+	// ShouldRetry reports whether the request should be attempted again after the given failure.
+	ShouldRetry(attempt RetryAttempt) bool
+	// Delay returns how long to wait before the next attempt.
+	Delay(attempt RetryAttempt) time.Duration
+	// RotateNode reports whether the next attempt should move on to the next node in the request's node list.
+	RotateNode(attempt RetryAttempt) bool
+}
+
+// RetryAttempt describes a failed attempt and is passed to a RetryPolicy.
+type RetryAttempt struct {
+	// Attempt is the zero-based index of the attempt that failed
+	Attempt int64
+	// NodeAccountID is the node the attempt was sent to
+	NodeAccountID AccountID
+	// GrpcError is the transport error of the attempt, or nil if the node answered
+	GrpcError error
+	// Status is the status the node answered with when GrpcError is nil. This is the precheck status, except for
+	// receipt queries where it is the receipt status once the precheck passed.
+	Status Status
+	// Retryable reports whether the SDK's built-in rules for this request type consider the failure retryable
+	Retryable bool
+	// MinBackoff and MaxBackoff are the backoff bounds configured on the request
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// PreviousDelay is the delay returned for the previous failed attempt, or zero for the first one
+	PreviousDelay time.Duration
+}
+
+// BackoffStrategy selects how DefaultRetryPolicy spaces out retries.
+type BackoffStrategy uint32
+
+const (
+	// BackoffStrategyExponential doubles the delay with every attempt, starting at MinBackoff and capped at MaxBackoff.
+	BackoffStrategyExponential BackoffStrategy = 0
+	// BackoffStrategyFullJitter picks a random delay between zero and the exponential delay.
+	BackoffStrategyFullJitter BackoffStrategy = 1
+	// BackoffStrategyDecorrelatedJitter picks a random delay between MinBackoff and three times the previous delay,
+	// capped at MaxBackoff.
+	BackoffStrategyDecorrelatedJitter BackoffStrategy = 2
+)
+
+// String returns the name of the strategy
+func (strategy BackoffStrategy) String() string {
+	switch strategy {
+	case BackoffStrategyExponential:
+		return "EXPONENTIAL"
+	case BackoffStrategyFullJitter:
+		return "FULL_JITTER"
+	case BackoffStrategyDecorrelatedJitter:
+		return "DECORRELATED_JITTER"
+	}
+
+	panic("unreachable: BackoffStrategy.String() switch statement is non-exhaustive")
+}
+
+// DefaultRetryPolicy is the RetryPolicy used when none is configured. Out of the box it retries whatever the request
+// type's built-in rules consider retryable (BUSY, PLATFORM_NOT_ACTIVE, gRPC UNAVAILABLE, ...), backs off
+// exponentially and moves on to the next node after every failure.
+//
+// Transport errors are never delayed by the policy: the failing node is put into its own backoff by the network and
+// the next attempt goes to another node.
+type DefaultRetryPolicy struct {
+	strategy          BackoffStrategy
+	retryableStatuses map[Status]bool
+	retryableCodes    map[codes.Code]bool
+	rotateNodes       bool
+
+	randMutex sync.Mutex
+	rand      *rand.Rand
+}
+
+// defaultRetryPolicy is used by clients that were constructed without a policy
+var defaultRetryPolicy RetryPolicy = NewDefaultRetryPolicy()
+
+// NewDefaultRetryPolicy creates a DefaultRetryPolicy with the SDK's built-in behaviour.
+func NewDefaultRetryPolicy() *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		strategy:          BackoffStrategyExponential,
+		retryableStatuses: make(map[Status]bool),
+		retryableCodes:    make(map[codes.Code]bool),
+		rotateNodes:       true,
+		rand:              rand.New(rand.NewSource(time.Now().UnixNano())), // #nosec
+	}
+}
+
+// SetBackoffStrategy sets how delays between attempts are computed.
+func (policy *DefaultRetryPolicy) SetBackoffStrategy(strategy BackoffStrategy) *DefaultRetryPolicy {
+	policy.strategy = strategy
+	return policy
+}
+
+// GetBackoffStrategy returns how delays between attempts are computed.
+func (policy *DefaultRetryPolicy) GetBackoffStrategy() BackoffStrategy {
+	return policy.strategy
+}
+
+// AddRetryableStatus makes the policy also retry when a node answers with one of the given statuses.
+func (policy *DefaultRetryPolicy) AddRetryableStatus(statuses ...Status) *DefaultRetryPolicy {
+	for _, s := range statuses {
+		policy.retryableStatuses[s] = true
+	}
+	return policy
+}
+
+// AddRetryableCode makes the policy also retry when an attempt fails with one of the given gRPC codes.
+func (policy *DefaultRetryPolicy) AddRetryableCode(grpcCodes ...codes.Code) *DefaultRetryPolicy {
+	for _, code := range grpcCodes {
+		policy.retryableCodes[code] = true
+	}
+	return policy
+}
+
+// SetRotateNodes sets whether a retry moves on to the next node. When false, retries stay on the same node.
+func (policy *DefaultRetryPolicy) SetRotateNodes(rotate bool) *DefaultRetryPolicy {
+	policy.rotateNodes = rotate
+	return policy
+}
+
+// GetRotateNodes returns whether a retry moves on to the next node.
+func (policy *DefaultRetryPolicy) GetRotateNodes() bool {
+	return policy.rotateNodes
+}
+
+// ShouldRetry implements RetryPolicy
+func (policy *DefaultRetryPolicy) ShouldRetry(attempt RetryAttempt) bool {
+	if attempt.Retryable {
+		return true
+	}
+
+	if attempt.GrpcError != nil {
+		return policy.retryableCodes[status.Code(attempt.GrpcError)]
+	}
+
+	return policy.retryableStatuses[attempt.Status]
+}
+
+// Delay implements RetryPolicy
+func (policy *DefaultRetryPolicy) Delay(attempt RetryAttempt) time.Duration {
+	if attempt.GrpcError != nil {
+		return 0
+	}
+
+	switch policy.strategy {
+	case BackoffStrategyFullJitter:
+		return policy._RandomDuration(0, _ExponentialBackoff(attempt.MinBackoff, attempt.MaxBackoff, attempt.Attempt))
+	case BackoffStrategyDecorrelatedJitter:
+		upper := attempt.PreviousDelay * 3
+		if upper < attempt.MinBackoff {
+			upper = attempt.MinBackoff
+		}
+		if upper > attempt.MaxBackoff {
+			upper = attempt.MaxBackoff
+		}
+		return policy._RandomDuration(attempt.MinBackoff, upper)
+	default:
+		return _ExponentialBackoff(attempt.MinBackoff, attempt.MaxBackoff, attempt.Attempt)
+	}
+}
+
+// RotateNode implements RetryPolicy
+func (policy *DefaultRetryPolicy) RotateNode(RetryAttempt) bool {
+	return policy.rotateNodes
+}
+
+func (policy *DefaultRetryPolicy) _RandomDuration(min time.Duration, max time.Duration) time.Duration {
+	if max <= min {
+		return min
+	}
+
+	policy.randMutex.Lock()
+	defer policy.randMutex.Unlock()
+
+	return min + time.Duration(policy.rand.Int63n(int64(max-min)+1))
+}
+
+// _ExponentialBackoff returns min * 2^attempt, capped at max
+func _ExponentialBackoff(min time.Duration, max time.Duration, attempt int64) time.Duration {
+	backoff := min
+	for i := int64(0); i < attempt && backoff < max; i++ {
+		backoff *= 2
+	}
+
+	if backoff > max {
+		return max
+	}
+
+	return backoff
+}
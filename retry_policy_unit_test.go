@@ -0,0 +1,209 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type _RecordingRetryPolicy struct {
+	attempts []RetryAttempt
+	retry    bool
+}
+
+func (policy *_RecordingRetryPolicy) ShouldRetry(attempt RetryAttempt) bool {
+	policy.attempts = append(policy.attempts, attempt)
+	return policy.retry
+}
+
+func (policy *_RecordingRetryPolicy) Delay(RetryAttempt) time.Duration {
+	return 0
+}
+
+func (policy *_RecordingRetryPolicy) RotateNode(RetryAttempt) bool {
+	return true
+}
+
+func TestUnitRetryPolicyExponentialBackoff(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 250*time.Millisecond, _ExponentialBackoff(250*time.Millisecond, 8*time.Second, 0))
+	require.Equal(t, 500*time.Millisecond, _ExponentialBackoff(250*time.Millisecond, 8*time.Second, 1))
+	require.Equal(t, 4*time.Second, _ExponentialBackoff(250*time.Millisecond, 8*time.Second, 4))
+	require.Equal(t, 8*time.Second, _ExponentialBackoff(250*time.Millisecond, 8*time.Second, 5))
+	require.Equal(t, 8*time.Second, _ExponentialBackoff(250*time.Millisecond, 8*time.Second, 50))
+	require.Equal(t, time.Duration(0), _ExponentialBackoff(0, 8*time.Second, 3))
+}
+
+func TestUnitRetryPolicyDefaultDelay(t *testing.T) {
+	t.Parallel()
+
+	attempt := RetryAttempt{
+		Attempt:    3,
+		Status:     StatusBusy,
+		Retryable:  true,
+		MinBackoff: 250 * time.Millisecond,
+		MaxBackoff: 8 * time.Second,
+	}
+
+	policy := NewDefaultRetryPolicy()
+	require.Equal(t, BackoffStrategyExponential, policy.GetBackoffStrategy())
+	require.Equal(t, 2*time.Second, policy.Delay(attempt))
+
+	transportAttempt := attempt
+	transportAttempt.GrpcError = status.Error(codes.Unavailable, "unavailable")
+	require.Equal(t, time.Duration(0), policy.Delay(transportAttempt))
+
+	policy.SetBackoffStrategy(BackoffStrategyFullJitter)
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(attempt)
+		require.GreaterOrEqual(t, delay, time.Duration(0))
+		require.LessOrEqual(t, delay, 2*time.Second)
+	}
+
+	policy.SetBackoffStrategy(BackoffStrategyDecorrelatedJitter)
+	attempt.PreviousDelay = time.Second
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(attempt)
+		require.GreaterOrEqual(t, delay, 250*time.Millisecond)
+		require.LessOrEqual(t, delay, 3*time.Second)
+	}
+
+	attempt.PreviousDelay = 5 * time.Second
+	for i := 0; i < 100; i++ {
+		require.LessOrEqual(t, policy.Delay(attempt), 8*time.Second)
+	}
+}
+
+func TestUnitRetryPolicyDefaultShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := NewDefaultRetryPolicy()
+
+	require.True(t, policy.ShouldRetry(RetryAttempt{Status: StatusBusy, Retryable: true}))
+	require.False(t, policy.ShouldRetry(RetryAttempt{Status: StatusInvalidSignature}))
+	require.False(t, policy.ShouldRetry(RetryAttempt{GrpcError: status.Error(codes.DeadlineExceeded, "")}))
+
+	policy.AddRetryableStatus(StatusInvalidSignature).
+		AddRetryableCode(codes.DeadlineExceeded)
+
+	require.True(t, policy.ShouldRetry(RetryAttempt{Status: StatusInvalidSignature}))
+	require.True(t, policy.ShouldRetry(RetryAttempt{GrpcError: status.Error(codes.DeadlineExceeded, "")}))
+	require.False(t, policy.ShouldRetry(RetryAttempt{GrpcError: status.Error(codes.NotFound, "")}))
+
+	require.True(t, policy.RotateNode(RetryAttempt{}))
+	policy.SetRotateNodes(false)
+	require.False(t, policy.GetRotateNodes())
+	require.False(t, policy.RotateNode(RetryAttempt{}))
+}
+
+func TestUnitRetryPolicyTransactionOverride(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	policy := &_RecordingRetryPolicy{retry: false}
+
+	_, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		SetRetryPolicy(policy).
+		Execute(client)
+	require.Error(t, err)
+
+	var precheckErr ErrHederaPreCheckStatus
+	require.True(t, errors.As(err, &precheckErr))
+	require.Equal(t, StatusBusy, precheckErr.Status)
+
+	require.Len(t, policy.attempts, 1)
+	require.Equal(t, int64(0), policy.attempts[0].Attempt)
+	require.Equal(t, StatusBusy, policy.attempts[0].Status)
+	require.True(t, policy.attempts[0].Retryable)
+	require.Equal(t, AccountID{Account: 3}, policy.attempts[0].NodeAccountID)
+}
+
+func TestUnitRetryPolicyClientRetriesExtraStatus(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_INVALID_ACCOUNT_ID, ResponseType: services.ResponseType_ANSWER_ONLY},
+				},
+			},
+		},
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountID: &services.AccountID{ShardNum: 0, RealmNum: 0, Account: &services.AccountID_AccountNum{
+						AccountNum: 1800,
+					}},
+					Balance: 2000,
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.SetRetryPolicy(NewDefaultRetryPolicy().AddRetryableStatus(StatusInvalidAccountID))
+
+	balance, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetMinBackoff(0).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, HbarFromTinybar(2000), balance.Hbars)
+}
+
+func TestUnitRetryPolicyPinsNode(t *testing.T) {
+	t.Parallel()
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY,
+		},
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}, {}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	resp, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetContents([]byte("hello")).
+		SetMinBackoff(0).
+		SetRetryPolicy(NewDefaultRetryPolicy().SetRotateNodes(false)).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, AccountID{Account: 3}, resp.NodeID)
+}
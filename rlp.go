@@ -2,6 +2,8 @@ package hiero
 
 // SPDX-License-Identifier: Apache-2.0
 
+import "github.com/pkg/errors"
+
 // RLPType represents the type of RLP item.
 type RLPType int
 
@@ -10,6 +12,8 @@ const (
 	LIST_TYPE
 )
 
+var errRLPOutOfBounds = errors.New("RLP data is truncated or its lengths are out of bounds")
+
 // RLPItem represents a single RLP item.
 type RLPItem struct {
 	itemType   RLPType    // Type of the RLP item (value or list)
@@ -128,6 +132,10 @@ func (item *RLPItem) Read(bytes []byte) error {
 
 // decodeBytes decodes the bytes starting from the given index.
 func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
+	if *index >= len(bytes) {
+		return errRLPOutOfBounds
+	}
+
 	prefix := bytes[*index]
 	(*index)++
 
@@ -141,6 +149,9 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	// Short string case
 	if prefix < 0xB8 {
 		stringLength := int(prefix) - 0x80
+		if *index+stringLength > len(bytes) {
+			return errRLPOutOfBounds
+		}
 		item.itemValue = bytes[*index : *index+stringLength]
 		item.itemType = VALUE_TYPE
 		*index += stringLength
@@ -149,11 +160,9 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 
 	// Long string case
 	if prefix < 0xC0 {
-		stringLengthLength := int(prefix) - 0xB7
-		stringLength := 0
-		for i := 0; i < stringLengthLength; i++ {
-			stringLength = (stringLength << 8) + int(bytes[*index])
-			(*index)++
+		stringLength, err := decodeLength(bytes, index, int(prefix)-0xB7)
+		if err != nil {
+			return err
 		}
 		item.itemValue = bytes[*index : *index+stringLength]
 		item.itemType = VALUE_TYPE
@@ -162,31 +171,30 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	}
 
 	// Short list case
-	if prefix < 0xF7 {
-		listLength := int(prefix) - 0xC0
-		startIndex := *index
-		for *index < startIndex+listLength {
-			childItem := NewRLPItem(LIST_TYPE)
-			if err := childItem.decodeBytes(bytes, index); err != nil {
-				return err
-			}
-			item.PushBack(childItem)
-		}
-		item.itemType = LIST_TYPE
-		return nil
+	if prefix < 0xF8 {
+		return item.decodeList(bytes, index, int(prefix)-0xC0)
 	}
 
 	// Long list case
-	listLengthLength := int(prefix) - 0xF7
-	listLength := 0
-	for i := 0; i < listLengthLength; i++ {
-		listLength = (listLength << 8) + int(bytes[*index])
-		(*index)++
+	listLength, err := decodeLength(bytes, index, int(prefix)-0xF7)
+	if err != nil {
+		return err
+	}
+	return item.decodeList(bytes, index, listLength)
+}
+
+// decodeList decodes the child items of a list whose payload is listLength bytes long.
+func (item *RLPItem) decodeList(bytes []byte, index *int, listLength int) error {
+	endIndex := *index + listLength
+	if endIndex > len(bytes) {
+		return errRLPOutOfBounds
 	}
-	startIndex := *index
-	for *index < startIndex+listLength {
+
+	// Decode the payload on its own, so that child items can't read past the end of the list
+	payload := bytes[:endIndex]
+	for *index < endIndex {
 		childItem := NewRLPItem(LIST_TYPE)
-		if err := childItem.decodeBytes(bytes, index); err != nil {
+		if err := childItem.decodeBytes(payload, index); err != nil {
 			return err
 		}
 		item.PushBack(childItem)
@@ -194,3 +202,21 @@ func (item *RLPItem) decodeBytes(bytes []byte, index *int) error {
 	item.itemType = LIST_TYPE
 	return nil
 }
+
+// decodeLength decodes the big endian length of a long string or list, which is lengthLength bytes long.
+func decodeLength(bytes []byte, index *int, lengthLength int) (int, error) {
+	if lengthLength > 4 || *index+lengthLength > len(bytes) {
+		return 0, errRLPOutOfBounds
+	}
+
+	length := 0
+	for i := 0; i < lengthLength; i++ {
+		length = (length << 8) + int(bytes[*index])
+		(*index)++
+	}
+
+	if *index+length > len(bytes) {
+		return 0, errRLPOutOfBounds
+	}
+	return length, nil
+}
@@ -116,3 +116,36 @@ func TestRLPItemDecodeList(t *testing.T) {
 		assert.Equal(t, expectedItem.itemValue, item.childItems[i].itemValue) // Compare item values
 	}
 }
+
+func TestRLPItemDecodeListOf55Bytes(t *testing.T) {
+	t.Parallel()
+
+	// A list with a 55 byte payload is the longest short list, with the prefix 0xf7
+	item := NewRLPItem(LIST_TYPE)
+	item.PushBack(NewRLPItem(VALUE_TYPE).AssignValue(make([]byte, 54)))
+	encoded, err := item.Write()
+	require.NoError(t, err)
+	require.Equal(t, byte(0xf7), encoded[0])
+
+	decoded := NewRLPItem(LIST_TYPE)
+	require.NoError(t, decoded.Read(encoded))
+	require.Len(t, decoded.childItems, 1)
+	assert.Equal(t, make([]byte, 54), decoded.childItems[0].itemValue)
+}
+
+func TestRLPItemDecodeMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, bytes := range [][]byte{
+		{0x83, 0x01, 0x02},
+		{0xb8},
+		{0xb8, 0x38, 0x01},
+		{0xc8, 0x83, 'c', 'a', 't'},
+		{0xc2, 0x83, 'c', 'a', 't'},
+		{0xf9, 0x01},
+		{0xbf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	} {
+		item := NewRLPItem(LIST_TYPE)
+		assert.Error(t, item.Read(bytes), "%x", bytes)
+	}
+}
@@ -0,0 +1,485 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// SecretShareKind is the kind of secret a SecretShare is a part of
+type SecretShareKind byte
+
+const (
+	SecretShareKindEd25519PrivateKey SecretShareKind = iota + 1
+	SecretShareKindECDSAsecp256k1PrivateKey
+	SecretShareKindMnemonic
+)
+
+const secretShareVersion = 1
+const secretShareHeaderLength = 8
+const secretShareChecksumLength = 4
+const secretShareDigestLength = 4
+
+// secretShareMaxCount is the maximum number of shares of a secret, so that their indices never reach the x
+// coordinates of the secret and of its digest
+const secretShareMaxCount = 16
+
+// The x coordinates of the secret and of its digest, as in SLIP-39
+const secretShareSecretIndex = 255
+const secretShareDigestIndex = 254
+
+// SecretShare is one of the shares a private key or mnemonic is split into with Shamir's secret sharing. Any threshold
+// of the shares of a secret recover it; fewer reveal nothing about it.
+//
+// Shares are written as words of the English BIP-39 wordlist and carry a checksum, so that mistyped shares are
+// detected. The recovered secret is checked against a digest, as in SLIP-39, so that shares of different secrets are
+// not combined into a wrong one.
+type SecretShare struct {
+	identifier uint16
+	kind       SecretShareKind
+	language   MnemonicLanguage
+	threshold  uint8
+	index      uint8
+	value      []byte
+}
+
+// String returns the kind of secret shared
+func (kind SecretShareKind) String() string {
+	switch kind {
+	case SecretShareKindEd25519PrivateKey:
+		return "Ed25519PrivateKey"
+	case SecretShareKindECDSAsecp256k1PrivateKey:
+		return "ECDSAsecp256k1PrivateKey"
+	case SecretShareKindMnemonic:
+		return "Mnemonic"
+	default:
+		return fmt.Sprintf("SecretShareKind(%d)", byte(kind))
+	}
+}
+
+// SplitPrivateKey splits an Ed25519 or ECDSA secp256k1 private key into count shares, any threshold of which recover
+// it with CombinePrivateKey.
+func SplitPrivateKey(key PrivateKey, threshold int, count int) ([]SecretShare, error) {
+	switch {
+	case key.ed25519PrivateKey != nil:
+		return _SplitSecret(SecretShareKindEd25519PrivateKey, MnemonicLanguageEnglish, key.ed25519PrivateKey._BytesRaw(), threshold, count)
+	case key.ecdsaPrivateKey != nil:
+		return _SplitSecret(SecretShareKindECDSAsecp256k1PrivateKey, MnemonicLanguageEnglish, key.ecdsaPrivateKey._BytesRaw(), threshold, count)
+	default:
+		return nil, errors.New("private key is empty")
+	}
+}
+
+// SplitMnemonic splits a 12 or 24-word BIP-39 mnemonic into count shares, any threshold of which recover it with
+// CombineMnemonic. Legacy 22-word mnemonics can't be split.
+func SplitMnemonic(mnemonic Mnemonic, threshold int, count int) ([]SecretShare, error) {
+	entropy, err := mnemonic._Entropy()
+	if err != nil {
+		return nil, err
+	}
+
+	return _SplitSecret(SecretShareKindMnemonic, mnemonic.language, entropy, threshold, count)
+}
+
+// CombinePrivateKey recovers a private key from at least threshold of the shares SplitPrivateKey returned
+func CombinePrivateKey(shares []SecretShare) (PrivateKey, error) {
+	secret, kind, _, err := _CombineSecret(shares)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	switch kind {
+	case SecretShareKindEd25519PrivateKey:
+		return PrivateKeyFromBytesEd25519(secret)
+	case SecretShareKindECDSAsecp256k1PrivateKey:
+		return PrivateKeyFromBytesECDSA(secret)
+	default:
+		return PrivateKey{}, fmt.Errorf("shares are of a %v, not of a private key", kind)
+	}
+}
+
+// CombineMnemonic recovers a mnemonic, in its original language, from at least threshold of the shares SplitMnemonic
+// returned
+func CombineMnemonic(shares []SecretShare) (Mnemonic, error) {
+	secret, kind, language, err := _CombineSecret(shares)
+	if err != nil {
+		return Mnemonic{}, err
+	}
+
+	if kind != SecretShareKindMnemonic {
+		return Mnemonic{}, fmt.Errorf("shares are of a %v, not of a mnemonic", kind)
+	}
+	// SplitMnemonic only splits the entropy of 12 and 24-word mnemonics
+	if len(secret) != 16 && len(secret) != 32 {
+		return Mnemonic{}, fmt.Errorf("shares hold %v bytes, not the entropy of a 12 or 24-word mnemonic", len(secret))
+	}
+
+	return _MnemonicFromEntropy(secret, language)
+}
+
+// SecretShareFromString parses a share written as words separated by spaces
+func SecretShareFromString(s string) (SecretShare, error) {
+	return SecretShareFromWords(strings.Fields(s))
+}
+
+// SecretShareFromWords parses a share from its words. A share with a wrong checksum is rejected; if a word is not in
+// the wordlist, an ErrMnemonicUnknownWord suggests replacements.
+func SecretShareFromWords(words []string) (SecretShare, error) {
+	wordlist, err := MnemonicLanguageEnglish._Wordlist()
+	if err != nil {
+		return SecretShare{}, err
+	}
+
+	indices, err := wordlist._WordIndices(words)
+	if err != nil {
+		return SecretShare{}, err
+	}
+
+	data := make([]byte, len(indices)*11/8)
+	for i, index := range indices {
+		for bit := 0; bit < 11; bit++ {
+			position := i*11 + bit
+			if index&(1<<(10-bit)) == 0 {
+				continue
+			}
+			if position/8 >= len(data) {
+				return SecretShare{}, errors.New("invalid secret share padding")
+			}
+			data[position/8] |= 1 << (7 - position%8)
+		}
+	}
+
+	if len(data) < secretShareHeaderLength+secretShareChecksumLength {
+		return SecretShare{}, errors.New("secret share is too short")
+	}
+
+	length := secretShareHeaderLength + int(data[7]) + secretShareChecksumLength
+	if len(data) < length || len(indices) != (length*8+10)/11 {
+		return SecretShare{}, errors.New("invalid secret share length")
+	}
+
+	for _, b := range data[length:] {
+		if b != 0 {
+			return SecretShare{}, errors.New("invalid secret share padding")
+		}
+	}
+
+	payload, checksum := data[:length-secretShareChecksumLength], data[length-secretShareChecksumLength:length]
+	digest := sha256.Sum256(payload)
+	if !bytes.Equal(checksum, digest[:secretShareChecksumLength]) {
+		return SecretShare{}, errors.New("secret share checksum mismatch; a word is probably wrong")
+	}
+
+	if payload[0] != secretShareVersion {
+		return SecretShare{}, fmt.Errorf("unsupported secret share version: %v", payload[0])
+	}
+
+	share := SecretShare{
+		identifier: binary.BigEndian.Uint16(payload[1:3]),
+		kind:       SecretShareKind(payload[3]),
+		language:   MnemonicLanguage(payload[4]),
+		threshold:  payload[5],
+		index:      payload[6],
+		value:      append([]byte{}, payload[secretShareHeaderLength:]...),
+	}
+
+	if share.kind < SecretShareKindEd25519PrivateKey || share.kind > SecretShareKindMnemonic {
+		return SecretShare{}, fmt.Errorf("unsupported secret share kind: %v", payload[3])
+	}
+
+	if err := share._Validate(); err != nil {
+		return SecretShare{}, err
+	}
+
+	return share, nil
+}
+
+// Words returns the share as words of the English BIP-39 wordlist
+func (share SecretShare) Words() []string {
+	payload := make([]byte, 0, secretShareHeaderLength+len(share.value)+secretShareChecksumLength)
+	payload = append(payload, secretShareVersion)
+	payload = binary.BigEndian.AppendUint16(payload, share.identifier)
+	payload = append(payload, byte(share.kind), byte(share.language), share.threshold, share.index, byte(len(share.value)))
+	payload = append(payload, share.value...)
+
+	digest := sha256.Sum256(payload)
+	data := append(payload, digest[:secretShareChecksumLength]...)
+
+	// The last word is padded with zero bits
+	words := make([]string, (len(data)*8+10)/11)
+	for i := range words {
+		index := 0
+		for bit := 0; bit < 11; bit++ {
+			position := i*11 + bit
+			index <<= 1
+			if position/8 < len(data) {
+				index |= int((data[position/8] >> (7 - position%8)) & 1)
+			}
+		}
+		words[i] = wordlists.English[index]
+	}
+
+	return words
+}
+
+// String returns the words of the share separated by spaces
+func (share SecretShare) String() string {
+	return strings.Join(share.Words(), " ")
+}
+
+// Identifier returns the random identifier shared by all the shares of a secret
+func (share SecretShare) Identifier() uint16 {
+	return share.identifier
+}
+
+// Kind returns the kind of secret the share is a part of
+func (share SecretShare) Kind() SecretShareKind {
+	return share.kind
+}
+
+// Threshold returns the number of shares needed to recover the secret
+func (share SecretShare) Threshold() int {
+	return int(share.threshold)
+}
+
+// Index returns the index of the share among the shares of the secret, starting at 0
+func (share SecretShare) Index() int {
+	return int(share.index)
+}
+
+func _SplitSecret(kind SecretShareKind, language MnemonicLanguage, secret []byte, threshold int, count int) ([]SecretShare, error) {
+	if threshold < 1 || threshold > count {
+		return nil, fmt.Errorf("threshold must be between 1 and the number of shares, got %v of %v", threshold, count)
+	}
+	if count > secretShareMaxCount {
+		return nil, fmt.Errorf("a secret can be split into at most %v shares, got %v", secretShareMaxCount, count)
+	}
+	if len(secret) < 16 || len(secret) > 255 {
+		return nil, fmt.Errorf("invalid secret length: %v bytes", len(secret))
+	}
+
+	identifierBytes := make([]byte, 2)
+	if _, err := rand.Read(identifierBytes); err != nil {
+		return nil, err
+	}
+
+	values, err := _ShamirSplit(secret, threshold, count)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]SecretShare, count)
+	for i, value := range values {
+		shares[i] = SecretShare{
+			identifier: binary.BigEndian.Uint16(identifierBytes),
+			kind:       kind,
+			language:   language,
+			threshold:  uint8(threshold),
+			index:      uint8(i),
+			value:      value,
+		}
+	}
+
+	return shares, nil
+}
+
+// _Validate checks the threshold and index of a share, which are used as polynomial degree and x coordinate
+func (share SecretShare) _Validate() error {
+	if share.threshold < 1 || share.threshold > secretShareMaxCount {
+		return fmt.Errorf("invalid secret share threshold: %v", share.threshold)
+	}
+	if share.index >= secretShareMaxCount {
+		return fmt.Errorf("invalid secret share index: %v", share.index)
+	}
+
+	return nil
+}
+
+func _CombineSecret(shares []SecretShare) ([]byte, SecretShareKind, MnemonicLanguage, error) {
+	if len(shares) == 0 {
+		return nil, 0, 0, errors.New("no secret shares provided")
+	}
+
+	for _, share := range shares {
+		if err := share._Validate(); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+
+	first := shares[0]
+	if len(shares) < int(first.threshold) {
+		return nil, 0, 0, fmt.Errorf("%v secret shares are needed, got %v", first.threshold, len(shares))
+	}
+
+	// Only threshold shares are needed; shares past it are checked for consistency but not used
+	xs := make([]byte, 0, first.threshold)
+	ys := make([][]byte, 0, first.threshold)
+	seen := make(map[uint8]bool, len(shares))
+	for _, share := range shares {
+		if share.identifier != first.identifier || share.kind != first.kind || share.language != first.language ||
+			share.threshold != first.threshold || len(share.value) != len(first.value) {
+			return nil, 0, 0, errors.New("secret shares belong to different secrets")
+		}
+		if seen[share.index] {
+			return nil, 0, 0, fmt.Errorf("secret share %v was provided more than once", share.index)
+		}
+		seen[share.index] = true
+
+		if len(xs) < int(first.threshold) {
+			xs = append(xs, share.index)
+			ys = append(ys, share.value)
+		}
+	}
+
+	secret, err := _ShamirCombine(xs, ys)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return secret, first.kind, first.language, nil
+}
+
+// _ShamirSplit splits a secret into count values over GF(256), as SLIP-39 does: the polynomial goes through
+// threshold-2 random points, the secret at x = 255 and a digest of the secret at x = 254.
+func _ShamirSplit(secret []byte, threshold int, count int) ([][]byte, error) {
+	if threshold == 1 {
+		values := make([][]byte, count)
+		for i := range values {
+			values[i] = append([]byte{}, secret...)
+		}
+
+		return values, nil
+	}
+
+	randomPart := make([]byte, len(secret)-secretShareDigestLength)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+
+	xs := make([]byte, 0, threshold)
+	ys := make([][]byte, 0, threshold)
+	for i := 0; i < threshold-2; i++ {
+		value := make([]byte, len(secret))
+		if _, err := rand.Read(value); err != nil {
+			return nil, err
+		}
+
+		xs = append(xs, byte(i))
+		ys = append(ys, value)
+	}
+
+	xs = append(xs, secretShareDigestIndex, secretShareSecretIndex)
+	ys = append(ys, append(_SecretShareDigest(randomPart, secret), randomPart...), secret)
+
+	values := make([][]byte, count)
+	for i := range values {
+		if i < threshold-2 {
+			values[i] = ys[i]
+			continue
+		}
+
+		values[i] = _ShamirInterpolate(xs, ys, byte(i))
+	}
+
+	return values, nil
+}
+
+// _ShamirCombine recovers the secret from threshold values and checks it against the digest
+func _ShamirCombine(xs []byte, ys [][]byte) ([]byte, error) {
+	if len(xs) == 1 {
+		return append([]byte{}, ys[0]...), nil
+	}
+
+	secret := _ShamirInterpolate(xs, ys, secretShareSecretIndex)
+	digestValue := _ShamirInterpolate(xs, ys, secretShareDigestIndex)
+
+	digest, randomPart := digestValue[:secretShareDigestLength], digestValue[secretShareDigestLength:]
+	if !hmac.Equal(digest, _SecretShareDigest(randomPart, secret)) {
+		return nil, errors.New("invalid secret shares; the recovered secret does not match its digest")
+	}
+
+	return secret, nil
+}
+
+func _SecretShareDigest(randomPart []byte, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	_, _ = mac.Write(secret)
+
+	return mac.Sum(nil)[:secretShareDigestLength]
+}
+
+// _ShamirInterpolate evaluates at x the polynomial going through the points (xs[i], ys[i]), byte by byte, using
+// Lagrange interpolation over GF(256)
+func _ShamirInterpolate(xs []byte, ys [][]byte, x byte) []byte {
+	for i, xi := range xs {
+		if xi == x {
+			return append([]byte{}, ys[i]...)
+		}
+	}
+
+	result := make([]byte, len(ys[0]))
+	for i, xi := range xs {
+		// basis = prod (x - xj) / (xi - xj) for j != i; subtraction is xor in GF(256)
+		basis := byte(1)
+		for j, xj := range xs {
+			if i != j {
+				basis = _GF256Mul(basis, _GF256Div(x^xj, xi^xj))
+			}
+		}
+
+		for k := range result {
+			result[k] ^= _GF256Mul(basis, ys[i][k])
+		}
+	}
+
+	return result
+}
+
+var gf256Exp, gf256Log = _GF256Tables()
+
+// _GF256Tables builds the exponent and logarithm tables of GF(256) with the polynomial x^8 + x^4 + x^3 + x + 1 and
+// the generator x + 1, the field SLIP-39 uses
+func _GF256Tables() ([255]byte, [256]byte) {
+	var exp [255]byte
+	var log [256]byte
+
+	value := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = value
+		log[value] = byte(i)
+
+		// Multiply by x + 1
+		product := value << 1
+		if value&0x80 != 0 {
+			product ^= 0x1b
+		}
+		value ^= product
+	}
+
+	return exp, log
+}
+
+func _GF256Mul(a byte, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return gf256Exp[(int(gf256Log[a])+int(gf256Log[b]))%255]
+}
+
+func _GF256Div(a byte, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+
+	return gf256Exp[(int(gf256Log[a])+255-int(gf256Log[b]))%255]
+}
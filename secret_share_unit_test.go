@@ -0,0 +1,205 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitGF256(t *testing.T) {
+	t.Parallel()
+
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			product := _GF256Mul(byte(a), byte(b))
+			require.Equal(t, byte(a), _GF256Div(product, byte(b)))
+		}
+	}
+
+	// 0x53 and 0xca are inverses in the field of AES and SLIP-39
+	assert.Equal(t, byte(1), _GF256Mul(0x53, 0xca))
+}
+
+func TestUnitSecretSharePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	ecdsaKey, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	for _, key := range []PrivateKey{ed25519Key, ecdsaKey} {
+		shares, err := SplitPrivateKey(key, 3, 5)
+		require.NoError(t, err)
+		require.Len(t, shares, 5)
+
+		// Every combination of 3 shares, written down and read back, recovers the key
+		for i := 0; i < 5; i++ {
+			for j := i + 1; j < 5; j++ {
+				for k := j + 1; k < 5; k++ {
+					selected := make([]SecretShare, 0, 3)
+					for _, index := range []int{k, i, j} {
+						share, err := SecretShareFromString(shares[index].String())
+						require.NoError(t, err)
+						selected = append(selected, share)
+					}
+
+					recovered, err := CombinePrivateKey(selected)
+					require.NoError(t, err)
+					assert.Equal(t, key.String(), recovered.String())
+				}
+			}
+		}
+
+		assert.Len(t, shares[0].Words(), 32)
+		assert.Equal(t, 3, shares[0].Threshold())
+		assert.Equal(t, 4, shares[4].Index())
+
+		_, err = CombinePrivateKey(shares[:2])
+		require.ErrorContains(t, err, "3 secret shares are needed")
+
+		_, err = CombineMnemonic(shares)
+		require.Error(t, err)
+	}
+
+	shares, err := SplitPrivateKey(ecdsaKey, 1, 2)
+	require.NoError(t, err)
+	recovered, err := CombinePrivateKey(shares[1:])
+	require.NoError(t, err)
+	assert.Equal(t, ecdsaKey.String(), recovered.String())
+	assert.Equal(t, SecretShareKindECDSAsecp256k1PrivateKey, shares[0].Kind())
+}
+
+func TestUnitSecretShareMnemonic(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := MnemonicFromString(mnemonic24WordString)
+	require.NoError(t, err)
+
+	shares, err := SplitMnemonic(mnemonic, 2, 3)
+	require.NoError(t, err)
+
+	recovered, err := CombineMnemonic([]SecretShare{shares[2], shares[0]})
+	require.NoError(t, err)
+	assert.Equal(t, mnemonic.String(), recovered.String())
+
+	japanese, err := GenerateMnemonic12WithLanguage(MnemonicLanguageJapanese)
+	require.NoError(t, err)
+
+	shares, err = SplitMnemonic(japanese, 2, 2)
+	require.NoError(t, err)
+	recovered, err = CombineMnemonic(shares)
+	require.NoError(t, err)
+	assert.Equal(t, MnemonicLanguageJapanese, recovered.Language())
+	assert.Equal(t, japanese.ToSeed("pass"), recovered.ToSeed("pass"))
+
+	_, err = CombinePrivateKey(shares)
+	require.Error(t, err)
+}
+
+func TestUnitSecretShareInvalid(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	_, err = SplitPrivateKey(key, 4, 3)
+	require.Error(t, err)
+	_, err = SplitPrivateKey(key, 0, 3)
+	require.Error(t, err)
+	_, err = SplitPrivateKey(key, 2, 17)
+	require.Error(t, err)
+	_, err = SplitPrivateKey(PrivateKey{}, 2, 3)
+	require.Error(t, err)
+
+	shares, err := SplitPrivateKey(key, 2, 3)
+	require.NoError(t, err)
+	otherShares, err := SplitPrivateKey(key, 2, 3)
+	require.NoError(t, err)
+
+	_, err = CombinePrivateKey([]SecretShare{shares[0], otherShares[1]})
+	require.ErrorContains(t, err, "different secrets")
+
+	_, err = CombinePrivateKey([]SecretShare{shares[0], shares[0]})
+	require.ErrorContains(t, err, "more than once")
+
+	// A share that was altered consistently is caught by the digest
+	tampered := shares[1]
+	tampered.value = append([]byte{}, tampered.value...)
+	tampered.value[0] ^= 1
+	_, err = CombinePrivateKey([]SecretShare{shares[0], tampered})
+	require.ErrorContains(t, err, "does not match its digest")
+
+	// A mistyped word is caught by the checksum
+	words := shares[0].Words()
+	if words[10] == "abandon" {
+		words[10] = "ability"
+	} else {
+		words[10] = "abandon"
+	}
+	_, err = SecretShareFromWords(words)
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	_, err = SecretShareFromString(strings.Join(shares[0].Words()[:20], " "))
+	require.Error(t, err)
+
+	_, err = SplitMnemonic(Mnemonic{words: strings.Repeat("word ", 22)}, 2, 3)
+	require.Error(t, err)
+
+	// A mnemonic share can hold a secret of any length, but only 12 and 24-word mnemonics are split
+	for _, length := range []int{20, 40} {
+		crafted, err := _SplitSecret(SecretShareKindMnemonic, MnemonicLanguageEnglish, make([]byte, length), 1, 1)
+		require.NoError(t, err)
+		share, err := SecretShareFromString(crafted[0].String())
+		require.NoError(t, err)
+
+		_, err = CombineMnemonic([]SecretShare{share})
+		require.ErrorContains(t, err, "not the entropy of a 12 or 24-word mnemonic")
+	}
+
+	_, err = _MnemonicFromEntropy(make([]byte, 40), MnemonicLanguageEnglish)
+	require.Error(t, err)
+	_, err = _MnemonicFromEntropy(make([]byte, 17), MnemonicLanguageEnglish)
+	require.Error(t, err)
+}
+
+func TestUnitSecretShareInvalidThresholdAndIndex(t *testing.T) {
+	t.Parallel()
+
+	_, err := CombinePrivateKey([]SecretShare{{}})
+	require.ErrorContains(t, err, "threshold")
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	shares, err := SplitPrivateKey(key, 2, 3)
+	require.NoError(t, err)
+
+	// Shares with a valid checksum but a threshold of 0 or past 16
+	for _, threshold := range []uint8{0, 17} {
+		share := shares[0]
+		share.threshold = threshold
+
+		_, err = SecretShareFromWords(share.Words())
+		require.ErrorContains(t, err, "threshold")
+		_, err = CombinePrivateKey([]SecretShare{share, shares[1]})
+		require.ErrorContains(t, err, "threshold")
+	}
+
+	// Indices 254 and 255 are the x coordinates of the digest and the secret
+	for _, index := range []uint8{16, secretShareDigestIndex, secretShareSecretIndex} {
+		share := shares[0]
+		share.index = index
+
+		_, err = SecretShareFromWords(share.Words())
+		require.ErrorContains(t, err, "index")
+		_, err = CombinePrivateKey([]SecretShare{share, shares[1]})
+		require.ErrorContains(t, err, "index")
+	}
+}
@@ -0,0 +1,69 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Signer signs transactions and query payments with a key the SDK doesn't hold, e.g. in an HSM, a cloud KMS or a
+// remote signing service. Unlike TransactionSigner, a Signer can fail and is given the context of the execution it
+// signs for, so a slow or unavailable signer can be cancelled.
+type Signer interface {
+	// Sign returns the signature of message. ECDSA signatures may be returned with a leading recovery byte (65 bytes),
+	// which is stripped by the SDK.
+	Sign(ctx context.Context, message []byte) ([]byte, error)
+	// PublicKey returns the public key matching the signatures
+	PublicKey() PublicKey
+}
+
+// _TransactionSignerAdapter lets a TransactionSigner be used where a Signer is expected
+type _TransactionSignerAdapter struct {
+	publicKey PublicKey
+	signer    TransactionSigner
+}
+
+func _NewTransactionSignerAdapter(publicKey PublicKey, signer TransactionSigner) Signer {
+	if signer == nil {
+		return nil
+	}
+
+	return &_TransactionSignerAdapter{
+		publicKey: publicKey,
+		signer:    signer,
+	}
+}
+
+// Sign implements Signer
+func (adapter *_TransactionSignerAdapter) Sign(_ context.Context, message []byte) ([]byte, error) {
+	return adapter.signer(message), nil
+}
+
+// PublicKey implements Signer
+func (adapter *_TransactionSignerAdapter) PublicKey() PublicKey {
+	return adapter.publicKey
+}
+
+// _SignerSign signs message with signer, returning an error instead of an empty signature
+func _SignerSign(ctx context.Context, signer Signer, message []byte) ([]byte, error) {
+	if signer == nil {
+		return nil, errNilSigner
+	}
+
+	signature, err := signer.Sign(ctx, message)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign with key %s", signer.PublicKey().String())
+	}
+
+	if len(signature) == 0 {
+		return nil, errors.Wrapf(errEmptySignature, "failed to sign with key %s", signer.PublicKey().String())
+	}
+
+	if len(signature) == 65 {
+		signature = signature[1:]
+	}
+
+	return signature, nil
+}
@@ -0,0 +1,259 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type _TestSigner struct {
+	key   PrivateKey
+	err   error
+	delay time.Duration
+
+	mutex sync.Mutex
+	calls int
+}
+
+func (signer *_TestSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	signer.mutex.Lock()
+	signer.calls++
+	signer.mutex.Unlock()
+
+	if signer.delay > 0 {
+		select {
+		case <-time.After(signer.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if signer.err != nil {
+		return nil, signer.err
+	}
+
+	return signer.key.Sign(message), nil
+}
+
+func (signer *_TestSigner) PublicKey() PublicKey {
+	return signer.key.PublicKey()
+}
+
+func TestUnitSignerOperatorSignsTransaction(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	signer := &_TestSigner{key: key}
+
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		signedTransaction := services.SignedTransaction{}
+		require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+		require.Len(t, signedTransaction.SigMap.SigPair, 1)
+
+		sigPair := signedTransaction.SigMap.SigPair[0]
+		require.Equal(t, key.PublicKey().BytesRaw(), sigPair.PubKeyPrefix)
+		require.True(t, key.PublicKey().Verify(signedTransaction.BodyBytes, sigPair.GetEd25519()))
+
+		return &services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{call}})
+	defer server.Close()
+
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, signer)
+	require.Equal(t, key.PublicKey().String(), client.GetOperatorPublicKey().String())
+
+	_, err = NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.calls)
+}
+
+func TestUnitSignerErrorReturnedFromExecute(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errHsm := errors.New("hsm unavailable")
+
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, &_TestSigner{key: key, err: errHsm})
+
+	_, err = NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.ErrorIs(t, err, errHsm)
+}
+
+func TestUnitSignerEmptySignature(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	client.SetOperatorWith(AccountID{Account: 1800}, key.PublicKey(), func([]byte) []byte {
+		return nil
+	})
+
+	_, err = NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.ErrorIs(t, err, errEmptySignature)
+}
+
+func TestUnitSignerCancelledByContext(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, &_TestSigner{key: key, delay: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		ExecuteWithContext(ctx, client)
+
+	var contextErr ErrContextDone
+	require.ErrorAs(t, err, &contextErr)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestUnitSignerQueryPayment(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	errKms := errors.New("kms denied")
+
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, &_TestSigner{key: key, err: errKms})
+
+	_, err = NewAccountInfoQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetQueryPayment(HbarFromTinybar(25)).
+		Execute(client)
+	require.ErrorIs(t, err, errKms)
+}
+
+func TestUnitSignerSignWithSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	signer := &_TestSigner{key: key}
+
+	tx, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetContents([]byte("hello")).
+		Freeze()
+	require.NoError(t, err)
+
+	tx.SignWithSigner(signer)
+	// Signing twice with the same key is a no-op
+	tx.SignWithSigner(signer)
+
+	signatures, err := tx.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 3}], 0)
+
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, 1, signer.calls)
+
+	signatures, err = tx.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 3}], 1)
+
+	failing, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetContents([]byte("hello")).
+		Freeze()
+	require.NoError(t, err)
+
+	errRemote := errors.New("remote signer rejected the request")
+	failing.SignWithSigner(&_TestSigner{key: key, err: errRemote})
+
+	_, err = failing.ToBytes()
+	require.ErrorIs(t, err, errRemote)
+	_, err = failing.GetTransactionHash()
+	require.ErrorIs(t, err, errRemote)
+}
+
+func TestUnitSignerNil(t *testing.T) {
+	t.Parallel()
+
+	tx, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetContents([]byte("hello")).
+		Freeze()
+	require.NoError(t, err)
+
+	// A nil signer is ignored
+	tx.SignWithSigner(nil)
+	_, err = tx.ToBytes()
+	require.NoError(t, err)
+	signatures, err := tx.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 3}], 0)
+
+	client, server := NewMockClientAndServer([][]interface{}{{}})
+	defer server.Close()
+
+	// An operator without a signer can't sign transactions or query payments
+	client.SetOperatorWithSigner(AccountID{Account: 1800}, nil)
+
+	_, err = NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.ErrorIs(t, err, errNilSigner)
+
+	_, err = NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		SignWithOperator(client)
+	require.ErrorIs(t, err, errNilSigner)
+
+	_, err = NewAccountInfoQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		SetQueryPayment(HbarFromTinybar(25)).
+		Execute(client)
+	require.ErrorIs(t, err, errNilSigner)
+}
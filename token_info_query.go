@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -49,7 +50,12 @@ func (q *TokenInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the TopicInfoQuery using the provided client
 func (q *TokenInfoQuery) Execute(client *Client) (TokenInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *TokenInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (TokenInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return TokenInfo{}, err
@@ -84,6 +90,12 @@ func (q *TokenInfoQuery) SetMaxRetry(count int) *TokenInfoQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this TokenInfoQuery.
+func (q *TokenInfoQuery) SetRetryPolicy(policy RetryPolicy) *TokenInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *TokenInfoQuery) SetMaxBackoff(max time.Duration) *TokenInfoQuery {
@@ -0,0 +1,237 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// TopicCheckpoint is the position of a topic subscription: the last message delivered to onNext, and the chunks of
+// messages which were received but not yet fully reassembled at that point.
+type TopicCheckpoint struct {
+	TopicID            TopicID
+	ConsensusTimestamp time.Time
+	SequenceNumber     uint64
+	RunningHash        []byte
+	// PendingChunks are the serialized mirror node responses of the received chunks of partial messages
+	PendingChunks [][]byte
+}
+
+// CheckpointStore persists the checkpoints of topic subscriptions, so that a subscription resumes where it stopped
+// after the process restarts. Implementations must be safe for concurrent use.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved under key, or nil if there is none
+	Load(key string) (*TopicCheckpoint, error)
+	// Save replaces the checkpoint saved under key
+	Save(key string, checkpoint TopicCheckpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore which keeps checkpoints in memory, to resume subscriptions within a
+// process
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]TopicCheckpoint
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		checkpoints: make(map[string]TopicCheckpoint),
+	}
+}
+
+// Load returns the checkpoint saved under key, or nil if there is none
+func (store *MemoryCheckpointStore) Load(key string) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	checkpoint, ok := store.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+
+	checkpoint = checkpoint._Clone()
+	return &checkpoint, nil
+}
+
+// Save replaces the checkpoint saved under key
+func (store *MemoryCheckpointStore) Save(key string, checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.checkpoints[key] = checkpoint._Clone()
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore which keeps each checkpoint in a JSON file of a directory. Files are
+// replaced atomically, so a crash while saving leaves the previous checkpoint.
+type FileCheckpointStore struct {
+	mu        sync.Mutex
+	directory string
+}
+
+type _TopicCheckpointJSON struct {
+	TopicID            string    `json:"topicId"`
+	ConsensusTimestamp time.Time `json:"consensusTimestamp"`
+	SequenceNumber     uint64    `json:"sequenceNumber"`
+	RunningHash        string    `json:"runningHash,omitempty"`
+	PendingChunks      [][]byte  `json:"pendingChunks,omitempty"`
+}
+
+// NewFileCheckpointStore creates a FileCheckpointStore saving checkpoints in directory, which is created if it doesn't
+// exist
+func NewFileCheckpointStore(directory string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create checkpoint directory")
+	}
+
+	return &FileCheckpointStore{
+		directory: directory,
+	}, nil
+}
+
+// GetDirectory returns the directory the checkpoints are saved in
+func (store *FileCheckpointStore) GetDirectory() string {
+	return store.directory
+}
+
+func (store *FileCheckpointStore) _Path(key string) string {
+	return filepath.Join(store.directory, url.PathEscape(key)+".json")
+}
+
+// Load returns the checkpoint saved under key, or nil if there is none
+func (store *FileCheckpointStore) Load(key string) (*TopicCheckpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	data, err := os.ReadFile(store._Path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read checkpoint %q", key)
+	}
+
+	var checkpointJSON _TopicCheckpointJSON
+	if err := json.Unmarshal(data, &checkpointJSON); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse checkpoint %q", key)
+	}
+
+	topicID, err := TopicIDFromString(checkpointJSON.TopicID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse checkpoint %q", key)
+	}
+
+	runningHash, err := hex.DecodeString(checkpointJSON.RunningHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse checkpoint %q", key)
+	}
+
+	return &TopicCheckpoint{
+		TopicID:            topicID,
+		ConsensusTimestamp: checkpointJSON.ConsensusTimestamp,
+		SequenceNumber:     checkpointJSON.SequenceNumber,
+		RunningHash:        runningHash,
+		PendingChunks:      checkpointJSON.PendingChunks,
+	}, nil
+}
+
+// Save replaces the checkpoint saved under key
+func (store *FileCheckpointStore) Save(key string, checkpoint TopicCheckpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	data, err := json.Marshal(_TopicCheckpointJSON{
+		TopicID:            checkpoint.TopicID.String(),
+		ConsensusTimestamp: checkpoint.ConsensusTimestamp,
+		SequenceNumber:     checkpoint.SequenceNumber,
+		RunningHash:        hex.EncodeToString(checkpoint.RunningHash),
+		PendingChunks:      checkpoint.PendingChunks,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(store.directory, ".checkpoint-*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to save checkpoint %q", key)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "failed to save checkpoint %q", key)
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "failed to save checkpoint %q", key)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrapf(err, "failed to save checkpoint %q", key)
+	}
+
+	return errors.Wrapf(os.Rename(file.Name(), store._Path(key)), "failed to save checkpoint %q", key)
+}
+
+func (checkpoint TopicCheckpoint) _Clone() TopicCheckpoint {
+	checkpoint.RunningHash = append([]byte(nil), checkpoint.RunningHash...)
+
+	chunks := make([][]byte, len(checkpoint.PendingChunks))
+	for i, chunk := range checkpoint.PendingChunks {
+		chunks[i] = append([]byte(nil), chunk...)
+	}
+	checkpoint.PendingChunks = chunks
+
+	return checkpoint
+}
+
+// _PendingChunks returns the chunks of a checkpoint
+func (checkpoint TopicCheckpoint) _PendingChunks() ([]*mirror.ConsensusTopicResponse, error) {
+	chunks := make([]*mirror.ConsensusTopicResponse, 0, len(checkpoint.PendingChunks))
+	for _, data := range checkpoint.PendingChunks {
+		resp := &mirror.ConsensusTopicResponse{}
+		if err := protobuf.Unmarshal(data, resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the pending chunks of the checkpoint")
+		}
+		if resp.ChunkInfo == nil {
+			return nil, errors.New("pending chunk of the checkpoint has no chunk info")
+		}
+
+		chunks = append(chunks, resp)
+	}
+
+	return chunks, nil
+}
+
+// _TopicCheckpointOf returns the checkpoint after resp was delivered, while the pending chunks were received
+func _TopicCheckpointOf(topicID TopicID, resp *mirror.ConsensusTopicResponse, pending []*mirror.ConsensusTopicResponse) (TopicCheckpoint, error) {
+	checkpoint := TopicCheckpoint{
+		TopicID:            topicID,
+		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
+		SequenceNumber:     resp.SequenceNumber,
+		RunningHash:        resp.RunningHash,
+		PendingChunks:      make([][]byte, 0),
+	}
+
+	for _, chunk := range pending {
+		data, err := protobuf.Marshal(chunk)
+		if err != nil {
+			return TopicCheckpoint{}, err
+		}
+		checkpoint.PendingChunks = append(checkpoint.PendingChunks, data)
+	}
+
+	return checkpoint, nil
+}
+
+var errTopicCheckpointTopic = errors.New("a checkpoint store requires the topic ID of the query")
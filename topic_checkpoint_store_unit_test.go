@@ -0,0 +1,150 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestTopicCheckpoint(t *testing.T) TopicCheckpoint {
+	topicID := TopicID{Topic: 7}
+	chunk := &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: _TimeToProtobuf(time.Unix(1700000000, 1)),
+		Message:            []byte("hello "),
+		SequenceNumber:     1,
+		ChunkInfo: &services.ConsensusMessageChunkInfo{
+			InitialTransactionID: TransactionIDGenerate(AccountID{Account: 1001})._ToProtobuf(),
+			Total:                2,
+			Number:               1,
+		},
+	}
+	delivered := &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: _TimeToProtobuf(time.Unix(1700000000, 2)),
+		Message:            []byte("single"),
+		RunningHash:        []byte{1, 2, 3},
+		SequenceNumber:     2,
+	}
+
+	checkpoint, err := _TopicCheckpointOf(topicID, delivered, []*mirror.ConsensusTopicResponse{chunk})
+	require.NoError(t, err)
+
+	return checkpoint
+}
+
+func TestUnitTopicCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	checkpoint := _TestTopicCheckpoint(t)
+	assert.Equal(t, uint64(2), checkpoint.SequenceNumber)
+	assert.Equal(t, time.Unix(1700000000, 2), checkpoint.ConsensusTimestamp)
+	assert.Equal(t, []byte{1, 2, 3}, checkpoint.RunningHash)
+	require.Len(t, checkpoint.PendingChunks, 1)
+
+	chunks, err := checkpoint._PendingChunks()
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, []byte("hello "), chunks[0].Message)
+	assert.Equal(t, int32(2), chunks[0].ChunkInfo.Total)
+
+	checkpoint.PendingChunks = [][]byte{{0xff}}
+	_, err = checkpoint._PendingChunks()
+	require.Error(t, err)
+}
+
+func TestUnitMemoryCheckpointStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryCheckpointStore()
+	loaded, err := store.Load("0.0.7")
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+
+	checkpoint := _TestTopicCheckpoint(t)
+	require.NoError(t, store.Save("0.0.7", checkpoint))
+
+	// The store keeps its own copy of the checkpoint
+	checkpoint.RunningHash[0] = 9
+	loaded, err = store.Load("0.0.7")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, []byte{1, 2, 3}, loaded.RunningHash)
+	assert.Equal(t, uint64(2), loaded.SequenceNumber)
+}
+
+func TestUnitFileCheckpointStore(t *testing.T) {
+	t.Parallel()
+
+	directory := filepath.Join(t.TempDir(), "checkpoints")
+	store, err := NewFileCheckpointStore(directory)
+	require.NoError(t, err)
+	assert.Equal(t, directory, store.GetDirectory())
+
+	loaded, err := store.Load("consumer/0.0.7")
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+
+	checkpoint := _TestTopicCheckpoint(t)
+	require.NoError(t, store.Save("consumer/0.0.7", checkpoint))
+	checkpoint.SequenceNumber = 5
+	require.NoError(t, store.Save("consumer/0.0.7", checkpoint))
+
+	// A new store reads the checkpoints saved in the same directory
+	store, err = NewFileCheckpointStore(directory)
+	require.NoError(t, err)
+	loaded, err = store.Load("consumer/0.0.7")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, checkpoint.TopicID.String(), loaded.TopicID.String())
+	assert.True(t, checkpoint.ConsensusTimestamp.Equal(loaded.ConsensusTimestamp))
+	assert.Equal(t, uint64(5), loaded.SequenceNumber)
+	assert.Equal(t, checkpoint.RunningHash, loaded.RunningHash)
+	assert.Equal(t, checkpoint.PendingChunks, loaded.PendingChunks)
+
+	// Saving replaces the file, without leaving temporary files behind
+	entries, err := os.ReadDir(directory)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "consumer%2F0.0.7.json", entries[0].Name())
+
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "broken.json"), []byte("{"), 0o600))
+	_, err = store.Load("broken")
+	require.ErrorContains(t, err, `failed to parse checkpoint "broken"`)
+}
+
+func TestUnitTopicMessageQueryCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryCheckpointStore()
+	query := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetCheckpointStore(store)
+
+	assert.Equal(t, store, query.GetCheckpointStore())
+	assert.Equal(t, "0.0.7", query.GetCheckpointKey())
+	assert.Equal(t, "consumer", query.SetCheckpointKey("consumer").GetCheckpointKey())
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+	client.SetLedgerID(*NewLedgerIDTestnet())
+
+	_, err = NewTopicMessageQuery().SetCheckpointStore(store).Subscribe(client, func(TopicMessage) {})
+	require.ErrorContains(t, err, "requires the topic ID")
+
+	require.NoError(t, store.Save("0.0.8", _TestTopicCheckpoint(t)))
+	_, err = NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 8}).
+		SetCheckpointStore(store).
+		Subscribe(client, func(TopicMessage) {})
+	require.ErrorContains(t, err, "is for topic 0.0.7")
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -51,7 +52,12 @@ func (q *TopicInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the TopicInfoQuery using the provided client
 func (q *TopicInfoQuery) Execute(client *Client) (TopicInfo, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *TopicInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (TopicInfo, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		return TopicInfo{}, err
@@ -84,6 +90,12 @@ func (q *TopicInfoQuery) SetMaxRetry(count int) *TopicInfoQuery {
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this TopicInfoQuery.
+func (q *TopicInfoQuery) SetRetryPolicy(policy RetryPolicy) *TopicInfoQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *TopicInfoQuery) SetMaxBackoff(max time.Duration) *TopicInfoQuery {
@@ -0,0 +1,201 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+)
+
+const (
+	// defaultChunkTimeout is the default consensus time after its first chunk in which a chunked message must be
+	// complete
+	defaultChunkTimeout = 5 * time.Minute
+	// defaultMaxPendingChunkBytes is the default limit of the contents of the chunks of incomplete messages
+	defaultMaxPendingChunkBytes = 4 * 1024 * 1024
+	// defaultMaxPendingMessages is the default limit of the number of incomplete messages
+	defaultMaxPendingMessages = 1000
+)
+
+// _TopicMessageAssembler reassembles chunked topic messages. The chunks of a message may be received in any order and
+// more than once. A message is given up on when it doesn't complete in time, when the pending messages exceed their
+// budget, or when its chunks are inconsistent.
+//
+// Time is the consensus time of the received messages, so a message only times out once a later message is received.
+type _TopicMessageAssembler struct {
+	topicID     TopicID
+	timeout     time.Duration
+	maxBytes    uint64
+	maxMessages uint64
+	pending     map[string]*_PendingTopicMessage
+	bytes       uint64
+}
+
+// _PendingTopicMessage is a chunked message of which some chunks were received
+type _PendingTopicMessage struct {
+	key            string
+	transactionID  TransactionID
+	total          int32
+	received       []bool
+	chunks         []*mirror.ConsensusTopicResponse
+	bytes          uint64
+	firstTimestamp time.Time
+}
+
+// _NewTopicMessageAssembler creates an assembler for the messages of a topic. A zero timeout or limit disables it.
+func _NewTopicMessageAssembler(topicID TopicID, timeout time.Duration, maxBytes uint64, maxMessages uint64) *_TopicMessageAssembler {
+	return &_TopicMessageAssembler{
+		topicID:     topicID,
+		timeout:     timeout,
+		maxBytes:    maxBytes,
+		maxMessages: maxMessages,
+		pending:     make(map[string]*_PendingTopicMessage),
+	}
+}
+
+// _Expire gives up on the messages whose first chunk is older than the timeout at the consensus time now
+func (assembler *_TopicMessageAssembler) _Expire(now time.Time) []ErrIncompleteTopicMessage {
+	if assembler.timeout <= 0 {
+		return nil
+	}
+
+	expired := make([]*_PendingTopicMessage, 0)
+	for _, message := range assembler.pending {
+		if now.Sub(message.firstTimestamp) > assembler.timeout {
+			expired = append(expired, message)
+		}
+	}
+
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i]._Before(expired[j])
+	})
+
+	incomplete := make([]ErrIncompleteTopicMessage, 0, len(expired))
+	for _, message := range expired {
+		incomplete = append(incomplete, assembler._Remove(message, IncompleteTopicMessageTimeout))
+	}
+
+	return incomplete
+}
+
+// _Add adds a chunk. Once every chunk of its message was received, it returns them in the order they were received.
+// It also returns the messages given up on because of the chunk.
+func (assembler *_TopicMessageAssembler) _Add(resp *mirror.ConsensusTopicResponse) ([]*mirror.ConsensusTopicResponse, []ErrIncompleteTopicMessage) {
+	info := resp.ChunkInfo
+	transactionID := _TransactionIDFromProtobuf(info.InitialTransactionID)
+	key := transactionID.String()
+	message, ok := assembler.pending[key]
+
+	if info.Total < 1 || info.Number < 1 || info.Number > info.Total || (ok && message.total != info.Total) {
+		if !ok {
+			message = &_PendingTopicMessage{
+				key:           key,
+				transactionID: transactionID,
+				total:         info.Total,
+			}
+		}
+
+		incomplete := assembler._Remove(message, IncompleteTopicMessageInconsistent)
+		incomplete.Chunks = append(incomplete.Chunks, _NewTopicMessageChunk(resp))
+		return nil, []ErrIncompleteTopicMessage{incomplete}
+	}
+
+	if !ok {
+		message = &_PendingTopicMessage{
+			key:            key,
+			transactionID:  transactionID,
+			total:          info.Total,
+			received:       make([]bool, info.Total),
+			chunks:         make([]*mirror.ConsensusTopicResponse, 0, info.Total),
+			firstTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
+		}
+		assembler.pending[key] = message
+	}
+
+	// A chunk received again is ignored
+	if message.received[info.Number-1] {
+		return nil, nil
+	}
+
+	message.received[info.Number-1] = true
+	message.chunks = append(message.chunks, resp)
+	message.bytes += uint64(len(resp.Message))
+	assembler.bytes += uint64(len(resp.Message))
+
+	if len(message.chunks) == int(message.total) {
+		delete(assembler.pending, key)
+		assembler.bytes -= message.bytes
+		return message.chunks, nil
+	}
+
+	return nil, assembler._Evict()
+}
+
+// _Evict gives up on the oldest messages until the pending messages are within their budget
+func (assembler *_TopicMessageAssembler) _Evict() []ErrIncompleteTopicMessage {
+	incomplete := make([]ErrIncompleteTopicMessage, 0)
+	for (assembler.maxMessages > 0 && uint64(len(assembler.pending)) > assembler.maxMessages) ||
+		(assembler.maxBytes > 0 && assembler.bytes > assembler.maxBytes) {
+		var oldest *_PendingTopicMessage
+		for _, message := range assembler.pending {
+			if oldest == nil || message._Before(oldest) {
+				oldest = message
+			}
+		}
+
+		incomplete = append(incomplete, assembler._Remove(oldest, IncompleteTopicMessageEvicted))
+	}
+
+	return incomplete
+}
+
+// _Remove gives up on a message
+func (assembler *_TopicMessageAssembler) _Remove(message *_PendingTopicMessage, reason IncompleteTopicMessageReason) ErrIncompleteTopicMessage {
+	if _, ok := assembler.pending[message.key]; ok {
+		delete(assembler.pending, message.key)
+		assembler.bytes -= message.bytes
+	}
+
+	chunks := make([]TopicMessageChunk, 0, len(message.chunks)+1)
+	for _, chunk := range message.chunks {
+		chunks = append(chunks, _NewTopicMessageChunk(chunk))
+	}
+
+	total := uint64(0)
+	if message.total > 0 {
+		total = uint64(message.total)
+	}
+
+	return ErrIncompleteTopicMessage{
+		Reason:        reason,
+		TopicID:       assembler.topicID,
+		TransactionID: message.transactionID,
+		TotalChunks:   total,
+		Chunks:        chunks,
+	}
+}
+
+// _Pending returns the chunks of the incomplete messages, in consensus order
+func (assembler *_TopicMessageAssembler) _Pending() []*mirror.ConsensusTopicResponse {
+	chunks := make([]*mirror.ConsensusTopicResponse, 0)
+	for _, message := range assembler.pending {
+		chunks = append(chunks, message.chunks...)
+	}
+
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].SequenceNumber < chunks[j].SequenceNumber
+	})
+
+	return chunks
+}
+
+// _Before reports whether the first chunk of message was received before the first chunk of other
+func (message *_PendingTopicMessage) _Before(other *_PendingTopicMessage) bool {
+	if !message.firstTimestamp.Equal(other.firstTimestamp) {
+		return message.firstTimestamp.Before(other.firstTimestamp)
+	}
+
+	return message.key < other.key
+}
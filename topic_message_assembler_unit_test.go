@@ -0,0 +1,170 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var topicMessageAssemblerTestStart = time.Unix(1700000000, 0)
+
+// _TestChunk returns the response of a mirror node for a chunk of the message of transactionID, received seconds
+// after the start of the test
+func _TestChunk(transactionID TransactionID, sequenceNumber uint64, number int32, total int32, contents string, seconds int) *mirror.ConsensusTopicResponse {
+	return &mirror.ConsensusTopicResponse{
+		ConsensusTimestamp: _TimeToProtobuf(topicMessageAssemblerTestStart.Add(time.Duration(seconds) * time.Second)),
+		Message:            []byte(contents),
+		SequenceNumber:     sequenceNumber,
+		ChunkInfo: &services.ConsensusMessageChunkInfo{
+			InitialTransactionID: transactionID._ToProtobuf(),
+			Total:                total,
+			Number:               number,
+		},
+	}
+}
+
+func _TestTransactionID(account uint64) TransactionID {
+	return NewTransactionIDWithValidStart(AccountID{Account: account}, topicMessageAssemblerTestStart)
+}
+
+func TestUnitTopicMessageAssemblerOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	assembler := _NewTopicMessageAssembler(TopicID{Topic: 7}, time.Minute, 0, 0)
+	first, second := _TestTransactionID(1001), _TestTransactionID(1002)
+
+	// Chunks of two messages are interleaved, out of order and duplicated
+	sequence := []*mirror.ConsensusTopicResponse{
+		_TestChunk(first, 1, 2, 3, "lo ", 1),
+		_TestChunk(second, 2, 1, 2, "other ", 2),
+		_TestChunk(first, 3, 2, 3, "lo ", 3),
+		_TestChunk(first, 4, 1, 3, "hel", 4),
+		_TestChunk(second, 5, 1, 2, "other ", 5),
+		_TestChunk(second, 6, 2, 2, "message", 6),
+		_TestChunk(first, 7, 3, 3, "world", 7),
+	}
+
+	completed := make([]TopicMessage, 0)
+	for _, resp := range sequence {
+		require.Empty(t, assembler._Expire(_TimeFromProtobuf(resp.ConsensusTimestamp)))
+
+		message, incomplete := assembler._Add(resp)
+		require.Empty(t, incomplete)
+		if message != nil {
+			completed = append(completed, _TopicMessageOfMany(message))
+		}
+	}
+
+	require.Len(t, completed, 2)
+	assert.Equal(t, []byte("other message"), completed[0].Contents)
+	assert.Equal(t, uint64(6), completed[0].SequenceNumber)
+	assert.Equal(t, []byte("hello world"), completed[1].Contents)
+	assert.Equal(t, uint64(7), completed[1].SequenceNumber)
+	assert.Equal(t, first.String(), completed[1].TransactionID.String())
+	require.Len(t, completed[1].Chunks, 3)
+	assert.Equal(t, uint64(4), completed[1].Chunks[0].SequenceNumber)
+
+	assert.Empty(t, assembler.pending)
+	assert.Equal(t, uint64(0), assembler.bytes)
+}
+
+func TestUnitTopicMessageAssemblerTimeout(t *testing.T) {
+	t.Parallel()
+
+	assembler := _NewTopicMessageAssembler(TopicID{Topic: 7}, time.Minute, 0, 0)
+	lost, late := _TestTransactionID(1001), _TestTransactionID(1002)
+
+	_, incomplete := assembler._Add(_TestChunk(lost, 1, 1, 2, "never", 0))
+	require.Empty(t, incomplete)
+	_, incomplete = assembler._Add(_TestChunk(late, 2, 1, 2, "late ", 30))
+	require.Empty(t, incomplete)
+	assert.Len(t, assembler._Pending(), 2)
+
+	// The timeout is in consensus time, and only the first message is older than a minute
+	require.Empty(t, assembler._Expire(topicMessageAssemblerTestStart.Add(time.Minute)))
+	expired := assembler._Expire(topicMessageAssemblerTestStart.Add(61 * time.Second))
+	require.Len(t, expired, 1)
+	assert.Equal(t, IncompleteTopicMessageTimeout, expired[0].Reason)
+	assert.Equal(t, lost.String(), expired[0].TransactionID.String())
+	assert.Equal(t, uint64(2), expired[0].TotalChunks)
+	require.Len(t, expired[0].Chunks, 1)
+	assert.Equal(t, uint64(5), expired[0].Chunks[0].ContentSize)
+	assert.Contains(t, expired[0].Error(), "is incomplete with 1 of 2 chunks: timed out")
+
+	// A chunk of a message given up on starts a new message
+	message, _ := assembler._Add(_TestChunk(late, 3, 2, 2, "chunk", 62))
+	require.NotNil(t, message)
+	assert.Equal(t, []byte("late chunk"), _TopicMessageOfMany(message).Contents)
+
+	_, _ = assembler._Add(_TestChunk(lost, 4, 2, 2, "after", 63))
+	pending := assembler._Pending()
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint64(4), pending[0].SequenceNumber)
+
+	// Without a timeout, messages are kept
+	assembler = _NewTopicMessageAssembler(TopicID{Topic: 7}, 0, 0, 0)
+	_, _ = assembler._Add(_TestChunk(lost, 1, 1, 2, "never", 0))
+	assert.Empty(t, assembler._Expire(topicMessageAssemblerTestStart.Add(24*time.Hour)))
+}
+
+func TestUnitTopicMessageAssemblerBudget(t *testing.T) {
+	t.Parallel()
+
+	assembler := _NewTopicMessageAssembler(TopicID{Topic: 7}, 0, 0, 2)
+	for i := 1; i <= 2; i++ {
+		_, incomplete := assembler._Add(_TestChunk(_TestTransactionID(uint64(1000+i)), uint64(i), 1, 2, "chunk", i))
+		require.Empty(t, incomplete)
+	}
+
+	_, evicted := assembler._Add(_TestChunk(_TestTransactionID(1003), 3, 1, 2, "chunk", 3))
+	require.Len(t, evicted, 1)
+	assert.Equal(t, IncompleteTopicMessageEvicted, evicted[0].Reason)
+	assert.Equal(t, _TestTransactionID(1001).String(), evicted[0].TransactionID.String())
+	assert.Len(t, assembler.pending, 2)
+
+	assembler = _NewTopicMessageAssembler(TopicID{Topic: 7}, 0, 10, 0)
+	_, _ = assembler._Add(_TestChunk(_TestTransactionID(1001), 1, 1, 3, "123456", 1))
+	_, evicted = assembler._Add(_TestChunk(_TestTransactionID(1002), 2, 1, 3, "123456", 2))
+	require.Len(t, evicted, 1)
+	assert.Equal(t, _TestTransactionID(1001).String(), evicted[0].TransactionID.String())
+	assert.Equal(t, uint64(6), assembler.bytes)
+
+	// A message larger than the budget on its own is evicted too
+	_, evicted = assembler._Add(_TestChunk(_TestTransactionID(1002), 3, 2, 3, "123456", 3))
+	require.Len(t, evicted, 1)
+	assert.Len(t, evicted[0].Chunks, 2)
+	assert.Equal(t, uint64(0), assembler.bytes)
+	assert.Empty(t, assembler.pending)
+}
+
+func TestUnitTopicMessageAssemblerInconsistent(t *testing.T) {
+	t.Parallel()
+
+	assembler := _NewTopicMessageAssembler(TopicID{Topic: 7}, 0, 0, 0)
+	transactionID := _TestTransactionID(1001)
+
+	_, _ = assembler._Add(_TestChunk(transactionID, 1, 1, 3, "first", 1))
+	message, incomplete := assembler._Add(_TestChunk(transactionID, 2, 2, 2, "second", 2))
+	assert.Nil(t, message)
+	require.Len(t, incomplete, 1)
+	assert.Equal(t, IncompleteTopicMessageInconsistent, incomplete[0].Reason)
+	assert.Equal(t, uint64(3), incomplete[0].TotalChunks)
+	assert.Len(t, incomplete[0].Chunks, 2)
+	assert.Empty(t, assembler.pending)
+	assert.Equal(t, uint64(0), assembler.bytes)
+
+	_, incomplete = assembler._Add(_TestChunk(transactionID, 3, 3, 2, "third", 3))
+	require.Len(t, incomplete, 1)
+	assert.Equal(t, IncompleteTopicMessageInconsistent, incomplete[0].Reason)
+	assert.Len(t, incomplete[0].Chunks, 1)
+	assert.Empty(t, assembler.pending)
+}
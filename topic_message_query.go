@@ -13,6 +13,7 @@ import (
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -32,6 +33,21 @@ type TopicMessageQuery struct {
 	endTime           *time.Time
 	limit             uint64
 	mu                sync.Mutex
+
+	runningHashVerifier      *TopicRunningHashVerifier
+	verificationErrorHandler func(err ErrTopicMessageVerification)
+
+	checkpointStore        CheckpointStore
+	checkpointKey          string
+	checkpointErrorHandler func(err error)
+
+	streamBufferSize     int
+	streamOverflowPolicy TopicMessageStreamOverflowPolicy
+
+	chunkTimeout             time.Duration
+	maxPendingChunkBytes     uint64
+	maxPendingMessages       uint64
+	incompleteMessageHandler func(err ErrIncompleteTopicMessage)
 }
 
 // NewTopicMessageQuery creates TopicMessageQuery which
@@ -42,6 +58,17 @@ func NewTopicMessageQuery() *TopicMessageQuery {
 		errorHandler:      _DefaultErrorHandler,
 		retryHandler:      _DefaultRetryHandler,
 		completionHandler: _DefaultCompletionHandler,
+
+		verificationErrorHandler: _DefaultVerificationErrorHandler,
+		checkpointErrorHandler:   _DefaultCheckpointErrorHandler,
+
+		streamBufferSize:     defaultTopicMessageStreamBufferSize,
+		streamOverflowPolicy: TopicMessageStreamBlock,
+
+		chunkTimeout:             defaultChunkTimeout,
+		maxPendingChunkBytes:     defaultMaxPendingChunkBytes,
+		maxPendingMessages:       defaultMaxPendingMessages,
+		incompleteMessageHandler: _DefaultIncompleteMessageHandler,
 	}
 }
 
@@ -132,6 +159,129 @@ func (query *TopicMessageQuery) SetRetryHandler(retryHandler func(err error) boo
 	return query
 }
 
+// SetRunningHashVerifier Sets a verifier which checks the running hash and sequence number of every message, so that
+// messages which were dropped, reordered or forged by the mirror node are detected. Messages which fail verification
+// are passed to the verification error handler instead of onNext.
+func (query *TopicMessageQuery) SetRunningHashVerifier(verifier *TopicRunningHashVerifier) *TopicMessageQuery {
+	query.runningHashVerifier = verifier
+	return query
+}
+
+// GetRunningHashVerifier returns the running hash verifier of this query
+func (query *TopicMessageQuery) GetRunningHashVerifier() *TopicRunningHashVerifier {
+	return query.runningHashVerifier
+}
+
+// SetVerificationErrorHandler Sets the handler of messages which fail running hash verification
+func (query *TopicMessageQuery) SetVerificationErrorHandler(verificationErrorHandler func(err ErrTopicMessageVerification)) *TopicMessageQuery {
+	query.verificationErrorHandler = verificationErrorHandler
+	return query
+}
+
+// SetCheckpointStore Sets a store in which the subscription saves a checkpoint after each message delivered to onNext.
+// When a checkpoint was saved for the subscription, Subscribe resumes after the last delivered message instead of
+// starting at the start time, together with the chunks of messages which were partially received, and seeds the
+// running hash verifier.
+func (query *TopicMessageQuery) SetCheckpointStore(store CheckpointStore) *TopicMessageQuery {
+	query.checkpointStore = store
+	return query
+}
+
+// GetCheckpointStore returns the checkpoint store of this query
+func (query *TopicMessageQuery) GetCheckpointStore() CheckpointStore {
+	return query.checkpointStore
+}
+
+// SetCheckpointKey Sets the key under which the checkpoint of the subscription is saved. Defaults to the topic ID;
+// subscriptions to the same topic which progress independently need different keys.
+func (query *TopicMessageQuery) SetCheckpointKey(key string) *TopicMessageQuery {
+	query.checkpointKey = key
+	return query
+}
+
+// GetCheckpointKey returns the key under which the checkpoint of the subscription is saved
+func (query *TopicMessageQuery) GetCheckpointKey() string {
+	if query.checkpointKey == "" && query.topicID != nil {
+		return query.topicID.String()
+	}
+
+	return query.checkpointKey
+}
+
+// SetCheckpointErrorHandler Sets the handler of errors saving checkpoints. The subscription goes on, so a message
+// delivered since the last saved checkpoint is delivered again when the subscription resumes.
+func (query *TopicMessageQuery) SetCheckpointErrorHandler(checkpointErrorHandler func(err error)) *TopicMessageQuery {
+	query.checkpointErrorHandler = checkpointErrorHandler
+	return query
+}
+
+// SetStreamBufferSize Sets the number of results the channel returned by Stream holds before its overflow policy
+// applies. Defaults to 128. Without a buffer, a message overflows unless the consumer is waiting for it.
+func (query *TopicMessageQuery) SetStreamBufferSize(size int) *TopicMessageQuery {
+	query.streamBufferSize = size
+	return query
+}
+
+// GetStreamBufferSize returns the number of results the channel returned by Stream holds
+func (query *TopicMessageQuery) GetStreamBufferSize() int {
+	return query.streamBufferSize
+}
+
+// SetStreamOverflowPolicy Sets what Stream does with a message when its channel is full. Defaults to
+// TopicMessageStreamBlock.
+func (query *TopicMessageQuery) SetStreamOverflowPolicy(policy TopicMessageStreamOverflowPolicy) *TopicMessageQuery {
+	query.streamOverflowPolicy = policy
+	return query
+}
+
+// GetStreamOverflowPolicy returns what Stream does with a message when its channel is full
+func (query *TopicMessageQuery) GetStreamOverflowPolicy() TopicMessageStreamOverflowPolicy {
+	return query.streamOverflowPolicy
+}
+
+// SetChunkTimeout Sets the consensus time after its first chunk in which a chunked message must be complete. A message
+// times out when a message with a later consensus timestamp is received. Defaults to 5 minutes; zero disables it.
+func (query *TopicMessageQuery) SetChunkTimeout(timeout time.Duration) *TopicMessageQuery {
+	query.chunkTimeout = timeout
+	return query
+}
+
+// GetChunkTimeout returns the consensus time after its first chunk in which a chunked message must be complete
+func (query *TopicMessageQuery) GetChunkTimeout() time.Duration {
+	return query.chunkTimeout
+}
+
+// SetMaxPendingChunkBytes Sets the largest total size of the contents of the chunks of incomplete messages. When it's
+// exceeded, the oldest incomplete messages are given up on. Defaults to 4 MiB; zero disables it.
+func (query *TopicMessageQuery) SetMaxPendingChunkBytes(maxBytes uint64) *TopicMessageQuery {
+	query.maxPendingChunkBytes = maxBytes
+	return query
+}
+
+// GetMaxPendingChunkBytes returns the largest total size of the contents of the chunks of incomplete messages
+func (query *TopicMessageQuery) GetMaxPendingChunkBytes() uint64 {
+	return query.maxPendingChunkBytes
+}
+
+// SetMaxPendingMessages Sets the largest number of incomplete chunked messages. When it's exceeded, the oldest
+// incomplete message is given up on. Defaults to 1000; zero disables it.
+func (query *TopicMessageQuery) SetMaxPendingMessages(maxMessages uint64) *TopicMessageQuery {
+	query.maxPendingMessages = maxMessages
+	return query
+}
+
+// GetMaxPendingMessages returns the largest number of incomplete chunked messages
+func (query *TopicMessageQuery) GetMaxPendingMessages() uint64 {
+	return query.maxPendingMessages
+}
+
+// SetIncompleteMessageHandler Sets the handler of chunked messages which are given up on before every chunk was
+// received, because of the chunk timeout, the pending message limits or inconsistent chunks
+func (query *TopicMessageQuery) SetIncompleteMessageHandler(incompleteMessageHandler func(err ErrIncompleteTopicMessage)) *TopicMessageQuery {
+	query.incompleteMessageHandler = incompleteMessageHandler
+	return query
+}
+
 func (query *TopicMessageQuery) validateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -167,8 +317,33 @@ func (query *TopicMessageQuery) build() *mirror.ConsensusTopicQuery {
 	return body
 }
 
+// _TopicMessageHandlers are the callbacks of a subscription. onError and onComplete end it.
+type _TopicMessageHandlers struct {
+	onNext              func(TopicMessage)
+	onError             func(err error)
+	onComplete          func()
+	onVerificationError func(err ErrTopicMessageVerification)
+	onCheckpointError   func(err error)
+	onIncompleteMessage func(err ErrIncompleteTopicMessage)
+}
+
 // Subscribe subscribes to messages sent to the specific TopicID
 func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
+	return query._Subscribe(context.TODO(), client, _TopicMessageHandlers{
+		onNext: onNext,
+		onError: func(err error) {
+			grpcErr, _ := status.FromError(err)
+			query.errorHandler(*grpcErr)
+		},
+		onComplete:          query.completionHandler,
+		onVerificationError: query.verificationErrorHandler,
+		onCheckpointError:   query.checkpointErrorHandler,
+		onIncompleteMessage: query.incompleteMessageHandler,
+	})
+}
+
+// _Subscribe subscribes to messages sent to the specific TopicID until ctx is done
+func (query *TopicMessageQuery) _Subscribe(ctx context.Context, client *Client, handlers _TopicMessageHandlers) (SubscriptionHandle, error) {
 	var once sync.Once
 	done := make(chan struct{})
 	handle := SubscriptionHandle{}
@@ -178,9 +353,45 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 		return SubscriptionHandle{}, err
 	}
 
+	if query.runningHashVerifier != nil && query.topicID == nil {
+		return SubscriptionHandle{}, errTopicRunningHashVerifierTopic
+	}
+
 	pb := query.build()
 
-	messages := make(map[string][]*mirror.ConsensusTopicResponse)
+	assembler := _NewTopicMessageAssembler(query.GetTopicID(), query.chunkTimeout, query.maxPendingChunkBytes, query.maxPendingMessages)
+
+	var checkpoint *TopicCheckpoint
+	if query.checkpointStore != nil {
+		if query.topicID == nil {
+			return SubscriptionHandle{}, errTopicCheckpointTopic
+		}
+
+		checkpoint, err = query.checkpointStore.Load(query.GetCheckpointKey())
+		if err != nil {
+			return SubscriptionHandle{}, err
+		}
+	}
+
+	if checkpoint != nil {
+		if checkpoint.TopicID.String() != query.topicID.String() {
+			return SubscriptionHandle{}, errors.Errorf("checkpoint %q is for topic %s", query.GetCheckpointKey(), checkpoint.TopicID.String())
+		}
+
+		pending, err := checkpoint._PendingChunks()
+		if err != nil {
+			return SubscriptionHandle{}, err
+		}
+		for _, chunk := range pending {
+			assembler._Add(chunk)
+		}
+
+		pb.ConsensusStartTime = _TimeToProtobuf(checkpoint.ConsensusTimestamp.Add(1 * time.Nanosecond))
+
+		if query.runningHashVerifier != nil && len(checkpoint.RunningHash) > 0 {
+			query.runningHashVerifier.SetRunningHash(checkpoint.RunningHash, checkpoint.SequenceNumber)
+		}
+	}
 
 	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetConsensusServiceClient()
 	if err != nil {
@@ -197,19 +408,22 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 			if err != nil {
 				handle.Unsubscribe()
 
-				if grpcErr, ok := status.FromError(err); ok { // nolint
-					if query.attempt < query.maxAttempts && query.retryHandler(err) {
+				if _, ok := status.FromError(err); ok { // nolint
+					if ctx.Err() == nil && query.attempt < query.maxAttempts && query.retryHandler(err) {
 						subClient = nil
 
 						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
-						time.Sleep(time.Duration(delay) * time.Millisecond)
+						select {
+						case <-time.After(time.Duration(delay) * time.Millisecond):
+						case <-ctx.Done():
+						}
 						query.attempt++
 					} else {
-						query.errorHandler(*grpcErr)
+						handlers.onError(err)
 						break
 					}
 				} else if err == io.EOF {
-					query.completionHandler()
+					handlers.onComplete()
 					break
 				} else {
 					panic(err)
@@ -217,12 +431,12 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 			}
 
 			if subClient == nil {
-				ctx, cancel := context.WithCancel(context.TODO())
+				subCtx, cancel := context.WithCancel(ctx)
 				handle.onUnsubscribe = cancel
 				once.Do(func() {
 					close(done)
 				})
-				subClient, err = (*channel).SubscribeTopic(ctx, pb)
+				subClient, err = (*channel).SubscribeTopic(subCtx, pb)
 
 				if err != nil {
 					continue
@@ -244,22 +458,34 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 				pb.Limit--
 			}
 
-			if resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1 {
-				onNext(_TopicMessageOfSingle(resp))
-			} else {
-				txID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID).String()
-				message, ok := messages[txID]
-				if !ok {
-					message = make([]*mirror.ConsensusTopicResponse, 0, resp.ChunkInfo.Total)
+			// Messages up to the checkpoint were delivered before the subscription resumed
+			if checkpoint != nil && resp.SequenceNumber <= checkpoint.SequenceNumber {
+				continue
+			}
+
+			if query.runningHashVerifier != nil {
+				if violation := query.runningHashVerifier._Verify(*query.topicID, resp); violation != nil {
+					handlers.onVerificationError(*violation)
+					continue
 				}
+			}
 
-				message = append(message, resp)
-				messages[txID] = message
+			var incomplete []ErrIncompleteTopicMessage
+			if resp.ConsensusTimestamp != nil {
+				incomplete = assembler._Expire(_TimeFromProtobuf(resp.ConsensusTimestamp))
+			}
 
-				if int32(len(message)) == resp.ChunkInfo.Total {
-					delete(messages, txID)
+			if resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1 {
+				_ReportIncompleteTopicMessages(incomplete, handlers.onIncompleteMessage)
+				handlers.onNext(_TopicMessageOfSingle(resp))
+				query._SaveCheckpoint(resp, assembler._Pending(), handlers.onCheckpointError)
+			} else {
+				message, evicted := assembler._Add(resp)
+				_ReportIncompleteTopicMessages(append(incomplete, evicted...), handlers.onIncompleteMessage)
 
-					onNext(_TopicMessageOfMany(message))
+				if message != nil {
+					handlers.onNext(_TopicMessageOfMany(message))
+					query._SaveCheckpoint(resp, assembler._Pending(), handlers.onCheckpointError)
 				}
 			}
 		}
@@ -268,10 +494,44 @@ func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessa
 	return handle, nil
 }
 
+// _ReportIncompleteTopicMessages passes the messages given up on to the handler
+func _ReportIncompleteTopicMessages(incomplete []ErrIncompleteTopicMessage, onIncompleteMessage func(err ErrIncompleteTopicMessage)) {
+	for _, err := range incomplete {
+		onIncompleteMessage(err)
+	}
+}
+
+// _SaveCheckpoint saves the checkpoint after resp was delivered, if the query has a checkpoint store
+func (query *TopicMessageQuery) _SaveCheckpoint(resp *mirror.ConsensusTopicResponse, pending []*mirror.ConsensusTopicResponse, onCheckpointError func(err error)) {
+	if query.checkpointStore == nil {
+		return
+	}
+
+	checkpoint, err := _TopicCheckpointOf(*query.topicID, resp, pending)
+	if err == nil {
+		err = query.checkpointStore.Save(query.GetCheckpointKey(), checkpoint)
+	}
+	if err != nil {
+		onCheckpointError(err)
+	}
+}
+
 func _DefaultErrorHandler(stat status.Status) {
 	println("Failed to subscribe to topic with status", stat.Code().String())
 }
 
+func _DefaultVerificationErrorHandler(err ErrTopicMessageVerification) {
+	println("Topic message failed verification:", err.Error())
+}
+
+func _DefaultCheckpointErrorHandler(err error) {
+	println("Failed to save topic subscription checkpoint:", err.Error())
+}
+
+func _DefaultIncompleteMessageHandler(err ErrIncompleteTopicMessage) {
+	println("Topic message is incomplete:", err.Error())
+}
+
 func _DefaultCompletionHandler() {
 	println("Subscription to topic finished")
 }
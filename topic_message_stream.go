@@ -0,0 +1,188 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// defaultTopicMessageStreamBufferSize is the default capacity of the channel returned by TopicMessageQuery.Stream
+const defaultTopicMessageStreamBufferSize = 128
+
+// TopicMessageStreamOverflowPolicy is what a topic message stream does with a message received while its channel is
+// full
+type TopicMessageStreamOverflowPolicy int
+
+const (
+	// TopicMessageStreamBlock waits until the consumer makes room, which stops receiving from the mirror node so that
+	// it slows down too
+	TopicMessageStreamBlock TopicMessageStreamOverflowPolicy = iota
+	// TopicMessageStreamDropOldest removes the oldest result of the channel to make room for the message
+	TopicMessageStreamDropOldest
+	// TopicMessageStreamDropNewest drops the message
+	TopicMessageStreamDropNewest
+	// TopicMessageStreamFail ends the stream with an ErrTopicMessageStreamOverflow
+	TopicMessageStreamFail
+)
+
+// String returns the name of the policy
+func (policy TopicMessageStreamOverflowPolicy) String() string {
+	switch policy {
+	case TopicMessageStreamBlock:
+		return "Block"
+	case TopicMessageStreamDropOldest:
+		return "DropOldest"
+	case TopicMessageStreamDropNewest:
+		return "DropNewest"
+	case TopicMessageStreamFail:
+		return "Fail"
+	default:
+		return fmt.Sprintf("TopicMessageStreamOverflowPolicy(%d)", int(policy))
+	}
+}
+
+// TopicMessageStreamResult is a message received by a topic message stream, or an error. Running hash verification,
+// checkpoint and incomplete message errors are followed by more results; any other error is the last result of the
+// stream.
+type TopicMessageStreamResult struct {
+	Message TopicMessage
+	Err     error
+}
+
+// _TopicMessageStream sends the messages of a subscription to a channel. Its methods are called by the goroutine
+// receiving from the mirror node.
+type _TopicMessageStream struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	results    chan TopicMessageStreamResult
+	policy     TopicMessageStreamOverflowPolicy
+	topicID    TopicID
+	overflowed bool
+	closed     bool
+}
+
+// Stream subscribes to messages sent to the specific TopicID and returns a channel receiving them, buffered and
+// handling overflow as set with SetStreamBufferSize and SetStreamOverflowPolicy. Errors are received in-band instead
+// of being passed to the error handlers of the query.
+//
+// The channel is closed once the end time or the limit of the query is reached, after the last error, or when ctx is
+// done. Cancel ctx to stop the stream before that.
+func (query *TopicMessageQuery) Stream(ctx context.Context, client *Client) (<-chan TopicMessageStreamResult, error) {
+	if query.streamBufferSize < 0 {
+		return nil, errors.New("stream buffer size can't be negative")
+	}
+
+	switch query.streamOverflowPolicy {
+	case TopicMessageStreamBlock, TopicMessageStreamDropOldest, TopicMessageStreamDropNewest, TopicMessageStreamFail:
+	default:
+		return nil, errors.Errorf("unknown stream overflow policy %s", query.streamOverflowPolicy)
+	}
+
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	stream := &_TopicMessageStream{
+		ctx:     ctx,
+		cancel:  cancel,
+		results: make(chan TopicMessageStreamResult, query.streamBufferSize),
+		policy:  query.streamOverflowPolicy,
+		topicID: query.GetTopicID(),
+	}
+
+	_, err := query._Subscribe(subscriptionCtx, client, _TopicMessageHandlers{
+		onNext: func(message TopicMessage) {
+			stream._Send(TopicMessageStreamResult{Message: message})
+		},
+		onError: stream._Close,
+		onComplete: func() {
+			stream._Close(nil)
+		},
+		onVerificationError: func(err ErrTopicMessageVerification) {
+			stream._Send(TopicMessageStreamResult{Err: err})
+		},
+		onCheckpointError: func(err error) {
+			stream._Send(TopicMessageStreamResult{Err: err})
+		},
+		onIncompleteMessage: func(err ErrIncompleteTopicMessage) {
+			stream._Send(TopicMessageStreamResult{Err: err})
+		},
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return stream.results, nil
+}
+
+// _Send sends a result to the channel, applying the overflow policy if it's full
+func (stream *_TopicMessageStream) _Send(result TopicMessageStreamResult) {
+	if stream.overflowed || stream.closed {
+		return
+	}
+
+	select {
+	case stream.results <- result:
+		return
+	default:
+	}
+
+	switch stream.policy {
+	case TopicMessageStreamBlock:
+		select {
+		case stream.results <- result:
+		case <-stream.ctx.Done():
+		}
+	case TopicMessageStreamDropOldest:
+		// Without a buffer there is no oldest result to drop
+		if cap(stream.results) == 0 {
+			return
+		}
+
+		for {
+			select {
+			case <-stream.results:
+			default:
+			}
+
+			select {
+			case stream.results <- result:
+				return
+			default:
+			}
+		}
+	case TopicMessageStreamDropNewest:
+	case TopicMessageStreamFail:
+		// The subscription ends with a cancellation error, which _Close replaces with the overflow
+		stream.overflowed = true
+		stream.cancel()
+	}
+}
+
+// _Close sends the error ending the subscription, if any, and closes the channel
+func (stream *_TopicMessageStream) _Close(err error) {
+	if stream.closed {
+		return
+	}
+	stream.closed = true
+	stream.cancel()
+
+	if stream.overflowed {
+		err = ErrTopicMessageStreamOverflow{
+			TopicID:    stream.topicID,
+			BufferSize: cap(stream.results),
+		}
+	} else if stream.ctx.Err() != nil {
+		err = nil
+	}
+
+	if err != nil {
+		select {
+		case stream.results <- TopicMessageStreamResult{Err: err}:
+		case <-stream.ctx.Done():
+		}
+	}
+
+	close(stream.results)
+}
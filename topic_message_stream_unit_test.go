@@ -0,0 +1,155 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// _NewTestTopicMessageStream creates a stream as Stream does, whose cancel function only cancels the subscription
+func _NewTestTopicMessageStream(ctx context.Context, policy TopicMessageStreamOverflowPolicy, size int) *_TopicMessageStream {
+	_, cancel := context.WithCancel(ctx)
+	return &_TopicMessageStream{
+		ctx:     ctx,
+		cancel:  cancel,
+		results: make(chan TopicMessageStreamResult, size),
+		policy:  policy,
+		topicID: TopicID{Topic: 7},
+	}
+}
+
+func _TestStreamResult(sequenceNumber uint64) TopicMessageStreamResult {
+	return TopicMessageStreamResult{Message: TopicMessage{SequenceNumber: sequenceNumber}}
+}
+
+// _DrainTopicMessageStream returns the sequence numbers of the messages and the errors received until the stream is closed
+func _DrainTopicMessageStream(stream *_TopicMessageStream) ([]uint64, []error) {
+	sequenceNumbers := make([]uint64, 0)
+	errs := make([]error, 0)
+	for result := range stream.results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		} else {
+			sequenceNumbers = append(sequenceNumbers, result.Message.SequenceNumber)
+		}
+	}
+
+	return sequenceNumbers, errs
+}
+
+func TestUnitTopicMessageStreamDrop(t *testing.T) {
+	t.Parallel()
+
+	stream := _NewTestTopicMessageStream(context.Background(), TopicMessageStreamDropOldest, 2)
+	for i := uint64(1); i <= 4; i++ {
+		stream._Send(_TestStreamResult(i))
+	}
+	stream._Close(nil)
+	sequenceNumbers, errs := _DrainTopicMessageStream(stream)
+	assert.Equal(t, []uint64{3, 4}, sequenceNumbers)
+	assert.Empty(t, errs)
+
+	stream = _NewTestTopicMessageStream(context.Background(), TopicMessageStreamDropNewest, 2)
+	for i := uint64(1); i <= 4; i++ {
+		stream._Send(_TestStreamResult(i))
+	}
+	stream._Close(nil)
+	sequenceNumbers, _ = _DrainTopicMessageStream(stream)
+	assert.Equal(t, []uint64{1, 2}, sequenceNumbers)
+
+	// Without a buffer, messages nobody waits for are dropped
+	stream = _NewTestTopicMessageStream(context.Background(), TopicMessageStreamDropOldest, 0)
+	stream._Send(_TestStreamResult(1))
+	stream._Close(nil)
+	sequenceNumbers, _ = _DrainTopicMessageStream(stream)
+	assert.Empty(t, sequenceNumbers)
+}
+
+func TestUnitTopicMessageStreamFail(t *testing.T) {
+	t.Parallel()
+
+	stream := _NewTestTopicMessageStream(context.Background(), TopicMessageStreamFail, 2)
+	for i := uint64(1); i <= 3; i++ {
+		stream._Send(_TestStreamResult(i))
+	}
+	require.True(t, stream.overflowed)
+
+	// The subscription then ends with the cancellation of its context
+	go stream._Close(status.Error(codes.Canceled, "context canceled"))
+
+	sequenceNumbers, errs := _DrainTopicMessageStream(stream)
+	assert.Equal(t, []uint64{1, 2}, sequenceNumbers)
+	require.Len(t, errs, 1)
+
+	var overflow ErrTopicMessageStreamOverflow
+	require.True(t, errors.As(errs[0], &overflow))
+	assert.Equal(t, 2, overflow.BufferSize)
+	assert.Equal(t, "stream of topic 0.0.7 messages overflowed its buffer of 2 results", overflow.Error())
+}
+
+func TestUnitTopicMessageStreamBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := _NewTestTopicMessageStream(ctx, TopicMessageStreamBlock, 1)
+	stream._Send(_TestStreamResult(1))
+
+	sent := make(chan struct{})
+	go func() {
+		stream._Send(_TestStreamResult(2))
+		close(sent)
+	}()
+
+	select {
+	case <-sent:
+		t.Fatal("send did not wait for room in the channel")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.Equal(t, uint64(1), (<-stream.results).Message.SequenceNumber)
+	<-sent
+	assert.Equal(t, uint64(2), (<-stream.results).Message.SequenceNumber)
+
+	// Cancelling the context unblocks the send, and the stream closes without an error
+	stream._Send(_TestStreamResult(3))
+	go func() {
+		stream._Send(_TestStreamResult(4))
+		stream._Close(status.Error(codes.Canceled, "context canceled"))
+	}()
+	cancel()
+
+	sequenceNumbers, errs := _DrainTopicMessageStream(stream)
+	assert.Equal(t, []uint64{3}, sequenceNumbers[:1])
+	assert.Empty(t, errs)
+}
+
+func TestUnitTopicMessageQueryStreamOptions(t *testing.T) {
+	t.Parallel()
+
+	query := NewTopicMessageQuery()
+	assert.Equal(t, 128, query.GetStreamBufferSize())
+	assert.Equal(t, TopicMessageStreamBlock, query.GetStreamOverflowPolicy())
+
+	query.SetStreamBufferSize(16).SetStreamOverflowPolicy(TopicMessageStreamDropOldest)
+	assert.Equal(t, 16, query.GetStreamBufferSize())
+	assert.Equal(t, "DropOldest", query.GetStreamOverflowPolicy().String())
+
+	client, err := _NewMockClient()
+	require.NoError(t, err)
+
+	_, err = query.SetStreamBufferSize(-1).Stream(context.Background(), client)
+	require.Error(t, err)
+	_, err = query.SetStreamBufferSize(1).SetStreamOverflowPolicy(TopicMessageStreamOverflowPolicy(9)).Stream(context.Background(), client)
+	require.ErrorContains(t, err, "TopicMessageStreamOverflowPolicy(9)")
+}
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -190,9 +191,17 @@ func (tx *TopicMessageSubmitTransaction) Schedule() (*ScheduleCreateTransaction,
 	return tx.Transaction.Schedule()
 }
 
-// Execute executes the Query with the provided client
+// Execute executes the Transaction with the provided client
 func (tx *TopicMessageSubmitTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping early if ctx is done
+func (tx *TopicMessageSubmitTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -202,7 +211,7 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 		return TransactionResponse{}, tx.freezeError
 	}
 
-	list, err := tx.ExecuteAll(client)
+	list, err := tx.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionResponse{}, err
@@ -218,6 +227,14 @@ func (tx *TopicMessageSubmitTransaction) Execute(
 // ExecuteAll executes the all the Transactions with the provided client
 func (tx *TopicMessageSubmitTransaction) ExecuteAll(
 	client *Client,
+) ([]TransactionResponse, error) {
+	return tx.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes the all the Transactions with the provided client, stopping early if ctx is done
+func (tx *TopicMessageSubmitTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
 ) ([]TransactionResponse, error) {
 	if !tx.IsFrozen() {
 		_, err := tx.FreezeWith(client)
@@ -232,17 +249,18 @@ func (tx *TopicMessageSubmitTransaction) ExecuteAll(
 	}
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(accountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		if client.operator.signer == nil {
+			return []TransactionResponse{}, errNilSigner
+		}
+
+		tx.SignWithSigner(client.operator.signer)
 	}
 
 	size := tx.signedTransactions._Length() / tx.nodeAccountIDs._Length()
 	list := make([]TransactionResponse, size)
 
 	for i := 0; i < size; i++ {
-		resp, err := _Execute(client, tx)
+		resp, err := _Execute(ctx, client, tx)
 
 		if err != nil {
 			return []TransactionResponse{}, err
@@ -0,0 +1,171 @@
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/pkg/errors"
+)
+
+// TopicRunningHashVersion is the version of the topic running hash algorithm computed by ComputeTopicRunningHash
+const TopicRunningHashVersion = 3
+
+// ComputeTopicRunningHash computes the version 3 running hash of a topic after a message was submitted to it, from
+// the running hash before it (48 zero bytes before the first message), the payer of the message transaction, the
+// topic, and the consensus timestamp, sequence number and contents of the message.
+func ComputeTopicRunningHash(
+	previousRunningHash []byte,
+	payer AccountID,
+	topicID TopicID,
+	consensusTimestamp time.Time,
+	sequenceNumber uint64,
+	message []byte,
+) []byte {
+	messageHash := sha512.Sum384(message)
+
+	data := make([]byte, 0, len(previousRunningHash)+8*9+4+len(messageHash))
+	data = append(data, previousRunningHash...)
+	data = binary.BigEndian.AppendUint64(data, TopicRunningHashVersion)
+	data = binary.BigEndian.AppendUint64(data, payer.Shard)
+	data = binary.BigEndian.AppendUint64(data, payer.Realm)
+	data = binary.BigEndian.AppendUint64(data, payer.Account)
+	data = binary.BigEndian.AppendUint64(data, topicID.Shard)
+	data = binary.BigEndian.AppendUint64(data, topicID.Realm)
+	data = binary.BigEndian.AppendUint64(data, topicID.Topic)
+	data = binary.BigEndian.AppendUint64(data, uint64(consensusTimestamp.Unix()))
+	data = binary.BigEndian.AppendUint32(data, uint32(consensusTimestamp.Nanosecond()))
+	data = binary.BigEndian.AppendUint64(data, sequenceNumber)
+	data = append(data, messageHash[:]...)
+
+	hash := sha512.Sum384(_JavaObjectStreamBlock(data))
+	return hash[:]
+}
+
+// _JavaObjectStreamBlock frames data the way a Java ObjectOutputStream writes it as primitive data, which is what
+// consensus nodes hash: the stream header followed by block data records of at most 1024 bytes.
+func _JavaObjectStreamBlock(data []byte) []byte {
+	framed := make([]byte, 0, len(data)+4+5*(len(data)/1024+1))
+	framed = append(framed, 0xac, 0xed, 0x00, 0x05)
+	for len(data) > 0 {
+		block := data[:min(len(data), 1024)]
+		if len(block) <= 0xff {
+			framed = append(framed, 0x77, byte(len(block)))
+		} else {
+			framed = append(framed, 0x7a)
+			framed = binary.BigEndian.AppendUint32(framed, uint32(len(block)))
+		}
+		framed = append(framed, block...)
+		data = data[len(block):]
+	}
+
+	return framed
+}
+
+// TopicRunningHashVerifier checks the integrity of the messages of a topic subscription: that none is missing, out of
+// order, altered or forged. It recomputes the running hash of every message from the previous running hash, and
+// compares it with the one reported by the mirror node.
+//
+// Without a known-good running hash, a subscription starting at the first message of a topic is verified from the
+// start, and otherwise the first message received is trusted.
+type TopicRunningHashVerifier struct {
+	mu             sync.Mutex
+	runningHash    []byte
+	sequenceNumber uint64
+}
+
+// NewTopicRunningHashVerifier creates a TopicRunningHashVerifier which trusts the first message it receives, unless it
+// is the first message of the topic.
+func NewTopicRunningHashVerifier() *TopicRunningHashVerifier {
+	return &TopicRunningHashVerifier{}
+}
+
+// SetRunningHash seeds the verifier with a known-good running hash and the sequence number of the message it is the
+// running hash of, for example from a TransactionReceipt, a TopicInfo or an earlier subscription. The next message is
+// expected to have the following sequence number.
+func (verifier *TopicRunningHashVerifier) SetRunningHash(runningHash []byte, sequenceNumber uint64) *TopicRunningHashVerifier {
+	verifier.mu.Lock()
+	defer verifier.mu.Unlock()
+
+	verifier.runningHash = append([]byte{}, runningHash...)
+	verifier.sequenceNumber = sequenceNumber
+	return verifier
+}
+
+// GetRunningHash returns the running hash of the last message verified, or the one it was seeded with.
+func (verifier *TopicRunningHashVerifier) GetRunningHash() []byte {
+	verifier.mu.Lock()
+	defer verifier.mu.Unlock()
+
+	return append([]byte{}, verifier.runningHash...)
+}
+
+// GetSequenceNumber returns the sequence number of the last message verified, or the one it was seeded with.
+func (verifier *TopicRunningHashVerifier) GetSequenceNumber() uint64 {
+	verifier.mu.Lock()
+	defer verifier.mu.Unlock()
+
+	return verifier.sequenceNumber
+}
+
+// _Verify checks a message received from the mirror node. Messages older than the last one are rejected, and after any
+// other violation the verifier continues from the message, so that one violation doesn't fail every later message.
+func (verifier *TopicRunningHashVerifier) _Verify(topicID TopicID, resp *mirror.ConsensusTopicResponse) *ErrTopicMessageVerification {
+	verifier.mu.Lock()
+	defer verifier.mu.Unlock()
+
+	violation := &ErrTopicMessageVerification{
+		TopicID:                topicID,
+		SequenceNumber:         resp.SequenceNumber,
+		ExpectedSequenceNumber: verifier.sequenceNumber + 1,
+		ConsensusTimestamp:     _TimeFromProtobuf(resp.ConsensusTimestamp),
+	}
+
+	if verifier.runningHash == nil && verifier.sequenceNumber == 0 && resp.SequenceNumber == 1 {
+		// The running hash before the first message of a topic is all zeros
+		verifier.runningHash = make([]byte, sha512.Size384)
+	}
+
+	if verifier.runningHash == nil {
+		verifier._Advance(resp)
+		return nil
+	}
+
+	if resp.SequenceNumber <= verifier.sequenceNumber {
+		violation.Violation = TopicMessageViolationOutOfOrder
+		return violation
+	}
+
+	switch {
+	case resp.SequenceNumber != verifier.sequenceNumber+1:
+		violation.Violation = TopicMessageViolationSequenceGap
+	case resp.RunningHashVersion != TopicRunningHashVersion:
+		violation.Violation = TopicMessageViolationUnsupportedVersion
+	case resp.ChunkInfo.GetInitialTransactionID().GetAccountID() == nil:
+		violation.Violation = TopicMessageViolationUnknownPayer
+	default:
+		payer := _AccountIDFromProtobuf(resp.ChunkInfo.InitialTransactionID.AccountID)
+		expected := ComputeTopicRunningHash(verifier.runningHash, *payer, topicID, violation.ConsensusTimestamp,
+			resp.SequenceNumber, resp.Message)
+		if bytes.Equal(expected, resp.RunningHash) {
+			verifier._Advance(resp)
+			return nil
+		}
+		violation.Violation = TopicMessageViolationRunningHashMismatch
+	}
+
+	verifier._Advance(resp)
+	return violation
+}
+
+func (verifier *TopicRunningHashVerifier) _Advance(resp *mirror.ConsensusTopicResponse) {
+	verifier.runningHash = append([]byte{}, resp.RunningHash...)
+	verifier.sequenceNumber = resp.SequenceNumber
+}
+
+var errTopicRunningHashVerifierTopic = errors.New("a running hash verifier requires the topic ID of the query")
@@ -0,0 +1,131 @@
+//go:build all || unit
+// +build all unit
+
+package hiero
+
+// SPDX-License-Identifier: Apache-2.0
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/mirror"
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestUnitTopicRunningHashJavaObjectStreamFraming(t *testing.T) {
+	t.Parallel()
+
+	// The running hash input is 172 bytes long, which fits in a short block data record
+	framed := _JavaObjectStreamBlock(make([]byte, 172))
+	assert.Equal(t, "aced000577ac", hex.EncodeToString(framed[:6]))
+	assert.Len(t, framed, 178)
+
+	framed = _JavaObjectStreamBlock(make([]byte, 1300))
+	assert.Equal(t, "aced00057a00000400", hex.EncodeToString(framed[:9]))
+	assert.Equal(t, "7a00000114", hex.EncodeToString(framed[9+1024:9+1024+5]))
+	assert.Len(t, framed, 4+5+1024+5+276)
+}
+
+// _TestTopicMessages returns the responses of a mirror node for messages of a topic, with valid running hashes
+func _TestTopicMessages(topicID TopicID, count int) []*mirror.ConsensusTopicResponse {
+	payer := AccountID{Account: 1001}
+	runningHash := make([]byte, 48)
+	timestamp := time.Unix(1700000000, 5)
+
+	responses := make([]*mirror.ConsensusTopicResponse, 0, count)
+	for i := 1; i <= count; i++ {
+		message := []byte{byte(i)}
+		runningHash = ComputeTopicRunningHash(runningHash, payer, topicID, timestamp, uint64(i), message)
+		responses = append(responses, &mirror.ConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(timestamp),
+			Message:            message,
+			RunningHash:        runningHash,
+			SequenceNumber:     uint64(i),
+			RunningHashVersion: TopicRunningHashVersion,
+			ChunkInfo: &services.ConsensusMessageChunkInfo{
+				InitialTransactionID: TransactionIDGenerate(payer)._ToProtobuf(),
+				Total:                1,
+				Number:               1,
+			},
+		})
+		timestamp = timestamp.Add(time.Second)
+	}
+
+	return responses
+}
+
+func TestUnitTopicRunningHashVerifier(t *testing.T) {
+	t.Parallel()
+
+	topicID := TopicID{Topic: 7}
+	responses := _TestTopicMessages(topicID, 5)
+
+	verifier := NewTopicRunningHashVerifier()
+	for _, resp := range responses[:3] {
+		require.Nil(t, verifier._Verify(topicID, resp))
+	}
+	assert.Equal(t, uint64(3), verifier.GetSequenceNumber())
+	assert.Equal(t, responses[2].RunningHash, verifier.GetRunningHash())
+
+	// A replayed message
+	violation := verifier._Verify(topicID, responses[1])
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationOutOfOrder, violation.Violation)
+	assert.Equal(t, uint64(4), violation.ExpectedSequenceNumber)
+
+	// A dropped message, after which the verifier continues
+	violation = verifier._Verify(topicID, responses[4])
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationSequenceGap, violation.Violation)
+	assert.Equal(t, uint64(5), violation.SequenceNumber)
+	assert.Contains(t, violation.Error(), "sequence gap")
+	assert.Equal(t, uint64(5), verifier.GetSequenceNumber())
+
+	// Messages of another topic have other running hashes
+	violation = NewTopicRunningHashVerifier()._Verify(TopicID{Topic: 8}, responses[0])
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationRunningHashMismatch, violation.Violation)
+}
+
+func TestUnitTopicRunningHashVerifierSeed(t *testing.T) {
+	t.Parallel()
+
+	topicID := TopicID{Topic: 7}
+	responses := _TestTopicMessages(topicID, 4)
+
+	// A verifier seeded with a known-good running hash catches a forged message
+	forged := protobuf.Clone(responses[2]).(*mirror.ConsensusTopicResponse)
+	forged.Message = []byte("forged")
+	verifier := NewTopicRunningHashVerifier().SetRunningHash(responses[1].RunningHash, 2)
+	violation := verifier._Verify(topicID, forged)
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationRunningHashMismatch, violation.Violation)
+
+	verifier.SetRunningHash(responses[1].RunningHash, 2)
+	require.Nil(t, verifier._Verify(topicID, responses[2]))
+
+	// Without a seed, the running hash of the first message received is trusted unless it's the first of the topic
+	forged.RunningHash = make([]byte, 48)
+	verifier = NewTopicRunningHashVerifier()
+	require.Nil(t, verifier._Verify(topicID, forged))
+	violation = verifier._Verify(topicID, responses[3])
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationRunningHashMismatch, violation.Violation)
+
+	unknownPayer := protobuf.Clone(responses[0]).(*mirror.ConsensusTopicResponse)
+	unknownPayer.ChunkInfo = nil
+	violation = NewTopicRunningHashVerifier()._Verify(topicID, unknownPayer)
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationUnknownPayer, violation.Violation)
+
+	oldVersion := protobuf.Clone(responses[0]).(*mirror.ConsensusTopicResponse)
+	oldVersion.RunningHashVersion = 2
+	violation = NewTopicRunningHashVerifier()._Verify(topicID, oldVersion)
+	require.NotNil(t, violation)
+	assert.Equal(t, TopicMessageViolationUnsupportedVersion, violation.Violation)
+}
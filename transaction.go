@@ -4,6 +4,7 @@ package hiero
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha512"
 	"fmt"
 	"reflect"
@@ -47,7 +48,7 @@ type BaseTransaction struct {
 	signedTransactions *_LockableSlice
 
 	publicKeys         []PublicKey
-	transactionSigners []TransactionSigner
+	transactionSigners []Signer
 }
 
 // Transaction is base struct for all transactions that may be built and submitted to hiero.
@@ -92,7 +93,7 @@ func TransactionFromBytes(data []byte) (TransactionInterface, error) { // nolint
 	minBackoff := 250 * time.Millisecond
 	maxBackoff := 8 * time.Second
 	publicKeys := make([]PublicKey, 0)
-	transactionSigners := make([]TransactionSigner, 0)
+	transactionSigners := make([]Signer, 0)
 	err := protobuf.Unmarshal(data, &list)
 	if err != nil {
 		return nil, errors.Wrap(err, "error deserializing from bytes to transaction List")
@@ -615,7 +616,7 @@ func (tx *Transaction[T]) _RequireOneNodeAccountID() {
 
 func (tx *Transaction[T]) _SignWith(
 	publicKey PublicKey,
-	signer TransactionSigner,
+	signer Signer,
 ) {
 	tx.transactions = _NewLockableSlice()
 	tx.publicKeys = append(tx.publicKeys, publicKey)
@@ -670,7 +671,7 @@ func (tx *Transaction[T]) buildUnsignedTransaction(index int) (*services.Transac
 	return &services.Transaction{BodyBytes: bodyBytes}, nil
 }
 
-func (tx *Transaction[T]) _SignTransaction(index int) {
+func (tx *Transaction[T]) _SignTransaction(ctx context.Context, index int) error {
 	initialTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 	bodyBytes := initialTx.GetBodyBytes()
 	if len(initialTx.SigMap.SigPair) != 0 {
@@ -679,12 +680,16 @@ func (tx *Transaction[T]) _SignTransaction(index int) {
 				if key.ed25519PublicKey != nil {
 					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ed25519PublicKey.keyData) {
 						if !tx.regenerateTransactionID {
-							return
+							return nil
 						}
 						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
 						case *services.SignaturePair_Ed25519:
-							if bytes.Equal(t.Ed25519, tx.transactionSigners[0](bodyBytes)) && len(t.Ed25519) > 0 {
-								return
+							signature, err := _SignerSign(ctx, tx.transactionSigners[0], bodyBytes)
+							if err != nil {
+								return err
+							}
+							if bytes.Equal(t.Ed25519, signature) && len(t.Ed25519) > 0 {
+								return nil
 							}
 						}
 					}
@@ -692,12 +697,16 @@ func (tx *Transaction[T]) _SignTransaction(index int) {
 				if key.ecdsaPublicKey != nil {
 					if bytes.Equal(initialTx.SigMap.SigPair[0].PubKeyPrefix, key.ecdsaPublicKey._BytesRaw()) {
 						if !tx.regenerateTransactionID {
-							return
+							return nil
 						}
 						switch t := initialTx.SigMap.SigPair[0].Signature.(type) { //nolint
 						case *services.SignaturePair_ECDSASecp256K1:
-							if bytes.Equal(t.ECDSASecp256K1, tx.transactionSigners[0](bodyBytes)) && len(t.ECDSASecp256K1) > 0 {
-								return
+							signature, err := _SignerSign(ctx, tx.transactionSigners[0], bodyBytes)
+							if err != nil {
+								return err
+							}
+							if bytes.Equal(t.ECDSASecp256K1, signature) && len(t.ECDSASecp256K1) > 0 {
+								return nil
 							}
 						}
 					}
@@ -720,20 +729,22 @@ func (tx *Transaction[T]) _SignTransaction(index int) {
 			continue
 		}
 
-		signature := signer(bodyBytes)
-		if len(signature) == 65 {
-			signature = signature[1:]
+		signature, err := _SignerSign(ctx, signer, bodyBytes)
+		if err != nil {
+			return err
 		}
 		modifiedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 		modifiedTx.SigMap.SigPair = append(modifiedTx.SigMap.SigPair, publicKey._ToSignaturePairProtobuf(signature))
 		tx.signedTransactions._Set(index, modifiedTx)
 	}
+
+	return nil
 }
 
 func (tx *Transaction[T]) _BuildAllTransactions() ([]*services.Transaction, error) {
 	allTx := make([]*services.Transaction, 0)
 	for i := 0; i < tx.signedTransactions._Length(); i++ {
-		curr, err := tx._BuildTransaction(i)
+		curr, err := tx._BuildTransaction(context.Background(), i)
 		tx.transactionIDs._Advance()
 		if err != nil {
 			return []*services.Transaction{}, err
@@ -744,7 +755,7 @@ func (tx *Transaction[T]) _BuildAllTransactions() ([]*services.Transaction, erro
 	return allTx, nil
 }
 
-func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, error) {
+func (tx *Transaction[T]) _BuildTransaction(ctx context.Context, index int) (*services.Transaction, error) {
 	signedTx := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 
 	txID := tx.transactionIDs._GetCurrent().(TransactionID)
@@ -790,7 +801,9 @@ func (tx *Transaction[T]) _BuildTransaction(index int) (*services.Transaction, e
 
 	signedTx.BodyBytes = updatedBody
 	tx.signedTransactions._Set(index, signedTx)
-	tx._SignTransaction(index)
+	if err := tx._SignTransaction(ctx, index); err != nil {
+		return &services.Transaction{}, err
+	}
 
 	signed := tx.signedTransactions._Get(index).(*services.SignedTransaction)
 	data, err := protobuf.Marshal(signed)
@@ -861,7 +874,7 @@ func (tx *Transaction[T]) GetSignatures() (map[AccountID]map[*PublicKey][]byte,
 }
 
 func (tx *Transaction[T]) GetTransactionHash() ([]byte, error) {
-	current, err := tx._BuildTransaction(0)
+	current, err := tx._BuildTransaction(context.Background(), 0)
 	if err != nil {
 		return nil, err
 	}
@@ -1033,6 +1046,8 @@ func (tx *Transaction[T]) SignWithOperator(client *Client) (T, error) { // nolin
 		return *new(T), errNoClientProvided
 	} else if client.operator == nil {
 		return *new(T), errClientOperatorSigning
+	} else if client.operator.signer == nil {
+		return *new(T), errNilSigner
 	}
 
 	if !tx.IsFrozen() {
@@ -1041,12 +1056,31 @@ func (tx *Transaction[T]) SignWithOperator(client *Client) (T, error) { // nolin
 			return *new(T), err
 		}
 	}
-	return tx.SignWith(client.operator.publicKey, client.operator.signer), nil
+	return tx.SignWithSigner(client.operator.signer), nil
 }
 func (tx *Transaction[T]) SignWith(publicKey PublicKey, signer TransactionSigner) T {
 	// We need to make sure the request is frozen
 	tx._RequireFrozen()
 
+	if !tx._KeyAlreadySigned(publicKey) {
+		tx._SignWith(publicKey, _NewTransactionSignerAdapter(publicKey, signer))
+	}
+
+	return tx.childTransaction
+}
+
+// SignWithSigner adds a signature from signer to the transaction. The signer is invoked when the transaction is
+// built, and any error it returns is returned from Execute, ToBytes or GetTransactionHash. A nil signer is ignored,
+// like a nil TransactionSigner in SignWith.
+func (tx *Transaction[T]) SignWithSigner(signer Signer) T {
+	if signer == nil {
+		return tx.childTransaction
+	}
+
+	// We need to make sure the request is frozen
+	tx._RequireFrozen()
+
+	publicKey := signer.PublicKey()
 	if !tx._KeyAlreadySigned(publicKey) {
 		tx._SignWith(publicKey, signer)
 	}
@@ -1119,11 +1153,9 @@ func (tx *Transaction[T]) shouldRetry(_ Executable, response interface{}) _Execu
 	return executionStateError
 }
 
-func (tx *Transaction[T]) makeRequest() interface{} {
+func (tx *Transaction[T]) makeRequest(ctx context.Context) (interface{}, error) {
 	index := tx.nodeAccountIDs._Length()*tx.transactionIDs.index + tx.nodeAccountIDs.index
-	built, _ := tx._BuildTransaction(index)
-
-	return built
+	return tx._BuildTransaction(ctx, index)
 }
 
 func (tx *Transaction[T]) advanceRequest() {
@@ -1175,7 +1207,14 @@ func (tx *Transaction[T]) regenerateID(client *Client) bool {
 	return false
 }
 
+// Execute executes the transaction with the provided client
 func (tx *Transaction[T]) Execute(client *Client) (TransactionResponse, error) {
+	return tx.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the transaction with the provided client. Cancelling ctx, or reaching its deadline,
+// aborts any in-flight gRPC call and retry backoff and returns ErrContextDone.
+func (tx *Transaction[T]) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
 	}
@@ -1194,17 +1233,18 @@ func (tx *Transaction[T]) Execute(client *Client) (TransactionResponse, error) {
 	transactionID := tx.transactionIDs._GetCurrent().(TransactionID)
 
 	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
-		tx.SignWith(
-			client.GetOperatorPublicKey(),
-			client.operator.signer,
-		)
+		if client.operator.signer == nil {
+			return TransactionResponse{}, errNilSigner
+		}
+
+		tx.SignWithSigner(client.operator.signer)
 	}
 
 	if tx.grpcDeadline == nil {
 		tx.grpcDeadline = client.requestTimeout
 	}
 
-	resp, err := _Execute(client, tx.childTransaction)
+	resp, err := _Execute(ctx, client, tx.childTransaction)
 
 	if err != nil {
 		return TransactionResponse{
@@ -1353,6 +1393,12 @@ func (tx *Transaction[T]) SetMaxRetry(max int) T {
 	return tx.childTransaction
 }
 
+// SetRetryPolicy overrides the client's retry policy for this transaction.
+func (tx *Transaction[T]) SetRetryPolicy(policy RetryPolicy) T {
+	tx.retryPolicy = policy
+	return tx.childTransaction
+}
+
 // GetNodeAccountIDs returns the node AccountID for this transaction.
 func (tx *Transaction[T]) GetLogLevel() *LogLevel {
 	return tx.logLevel
@@ -1370,6 +1416,11 @@ func TransactionExecute(tx TransactionInterface, client *Client) (TransactionRes
 	return tx.getBaseTransaction().Execute(client)
 }
 
+// TransactionExecuteWithContext executes tx with the provided client, stopping early if ctx is done
+func TransactionExecuteWithContext(ctx context.Context, tx TransactionInterface, client *Client) (TransactionResponse, error) {
+	return tx.getBaseTransaction().ExecuteWithContext(ctx, client)
+}
+
 func TransactionSign(tx TransactionInterface, key PrivateKey) (TransactionInterface, error) {
 	baseTx := tx.getBaseTransaction()
 	baseTx.Sign(key)
@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -83,7 +84,12 @@ func (q *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *TransactionReceiptQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err, ok := err.(ErrHederaPreCheckStatus); ok {
 		if resp.GetTransactionGetReceipt() != nil {
@@ -135,6 +141,12 @@ func (q *TransactionReceiptQuery) SetMaxRetry(count int) *TransactionReceiptQuer
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this TransactionReceiptQuery.
+func (q *TransactionReceiptQuery) SetRetryPolicy(policy RetryPolicy) *TransactionReceiptQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *TransactionReceiptQuery) SetMaxBackoff(max time.Duration) *TransactionReceiptQuery {
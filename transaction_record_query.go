@@ -3,6 +3,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"time"
 
 	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
@@ -87,7 +88,12 @@ func (q *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
 
 // Execute executes the Query with the provided client
 func (q *TransactionRecordQuery) Execute(client *Client) (TransactionRecord, error) {
-	resp, err := q.Query.execute(client, q)
+	return q.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping early if ctx is done
+func (q *TransactionRecordQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
+	resp, err := q.Query.executeWithContext(ctx, client, q)
 
 	if err != nil {
 		if precheckErr, ok := err.(ErrHederaPreCheckStatus); ok {
@@ -138,6 +144,12 @@ func (q *TransactionRecordQuery) SetMaxRetry(count int) *TransactionRecordQuery
 	return q
 }
 
+// SetRetryPolicy overrides the client's retry policy for this TransactionRecordQuery.
+func (q *TransactionRecordQuery) SetRetryPolicy(policy RetryPolicy) *TransactionRecordQuery {
+	q.Query.SetRetryPolicy(policy)
+	return q
+}
+
 // SetMaxBackoff The maximum amount of time to wait between retries.
 // Every retry attempt will increase the wait time exponentially until it reaches this time.
 func (q *TransactionRecordQuery) SetMaxBackoff(max time.Duration) *TransactionRecordQuery {
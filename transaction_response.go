@@ -1,6 +1,7 @@
 package hiero
 
 import (
+	"context"
 	"encoding/hex"
 
 	jsoniter "github.com/json-iterator/go"
@@ -35,28 +36,33 @@ func (response TransactionResponse) MarshalJSON() ([]byte, error) {
 }
 
 // retryTransaction is a helper function to retry a transaction that was throttled
-func retryTransaction(client *Client, transaction TransactionInterface) (TransactionReceipt, error) {
-	resp, err := TransactionExecute(transaction, client)
+func retryTransaction(ctx context.Context, client *Client, transaction TransactionInterface) (TransactionReceipt, error) {
+	resp, err := TransactionExecuteWithContext(ctx, transaction, client)
 	if err != nil {
 		return TransactionReceipt{}, err
 	}
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(resp.TransactionID).
 		SetNodeAccountIDs([]AccountID{resp.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	return receipt, err
 }
 
 // GetReceipt retrieves the receipt for the transaction
 func (response TransactionResponse) GetReceipt(client *Client) (TransactionReceipt, error) {
+	return response.GetReceiptWithContext(context.Background(), client)
+}
+
+// GetReceiptWithContext retrieves the receipt for the transaction, stopping early if ctx is done
+func (response TransactionResponse) GetReceiptWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
 		SetIncludeChildren(response.IncludeChildReceipts).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	for receipt.Status == StatusThrottledAtConsensus {
-		receipt, err = retryTransaction(client, response.Transaction)
+		receipt, err = retryTransaction(ctx, client, response.Transaction)
 	}
 
 	if err != nil {
@@ -68,13 +74,18 @@ func (response TransactionResponse) GetReceipt(client *Client) (TransactionRecei
 
 // GetRecord retrieves the record for the transaction
 func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
+	return response.GetRecordWithContext(context.Background(), client)
+}
+
+// GetRecordWithContext retrieves the record for the transaction, stopping early if ctx is done
+func (response TransactionResponse) GetRecordWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	for receipt.Status == StatusThrottledAtConsensus {
-		receipt, err = retryTransaction(client, response.Transaction)
+		receipt, err = retryTransaction(ctx, client, response.Transaction)
 	}
 
 	if err != nil {
@@ -85,7 +96,7 @@ func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord
 	return NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
 
 // GetReceiptQuery retrieves the receipt query for the transaction
@@ -6,6 +6,7 @@ package hiero
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -360,7 +361,7 @@ func TestUnitQueryRegression(t *testing.T) {
 		SetQueryPayment(HbarFromTinybar(25))
 
 	body := query.buildQuery()
-	_, err = query.generatePayments(client, HbarFromTinybar(20))
+	_, err = query.generatePayments(context.Background(), client, HbarFromTinybar(20))
 	require.NoError(t, err)
 
 	var paymentTx services.TransactionBody